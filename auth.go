@@ -0,0 +1,151 @@
+package habitica
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Credentials are what New needs to authenticate as a user.
+type Credentials struct {
+	ID       string `json:"id"`
+	APIToken string `json:"apiToken"`
+
+	// NewUser and Username are only set by AuthService.Login and Register.
+	NewUser  bool   `json:"newUser,omitempty"`
+	Username string `json:"username,omitempty"`
+}
+
+type CredentialsResponse = Envelope[*Credentials]
+
+// LocalAuthResponse holds the user's email after AuthService.UpdateEmail.
+type LocalAuthResponse = Envelope[*LocalAuth]
+
+type loginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type registerRequest struct {
+	Username        string `json:"username"`
+	Email           string `json:"email"`
+	Password        string `json:"password"`
+	ConfirmPassword string `json:"confirmPassword"`
+}
+
+type resetPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+type updatePasswordRequest struct {
+	Password        string `json:"password"`
+	NewPassword     string `json:"newPassword"`
+	ConfirmPassword string `json:"confirmPassword"`
+}
+
+type updateEmailRequest struct {
+	NewEmail string `json:"newEmail"`
+	Password string `json:"password"`
+}
+
+// AuthService manages the user's local login. Login, Register and
+// ResetPassword work with a client from NewUnauthenticated.
+type AuthService struct {
+	client *HabiticaClient
+}
+
+func newAuthService(h *HabiticaClient) *AuthService {
+	return &AuthService{
+		client: h,
+	}
+}
+
+// Login exchanges a username or email and password for the user's
+// credentials.
+func (s *AuthService) Login(ctx context.Context, username, password string) (*CredentialsResponse, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "user/auth/local/login", &loginRequest{
+		Username: username,
+		Password: password,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*Credentials](ctx, s.client, req)
+}
+
+// Register creates a new user and returns its credentials.
+func (s *AuthService) Register(ctx context.Context, username, email, password string) (*CredentialsResponse, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "user/auth/local/register", &registerRequest{
+		Username:        username,
+		Email:           email,
+		Password:        password,
+		ConfirmPassword: password,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*Credentials](ctx, s.client, req)
+}
+
+// ResetPassword emails a password reset link if the email belongs to a
+// user. The API responds the same way when it does not.
+func (s *AuthService) ResetPassword(ctx context.Context, email string) (*Envelope[struct{}], error) {
+	req, err := s.client.NewRequest(http.MethodPost, "user/reset-password", &resetPasswordRequest{Email: email})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[struct{}](ctx, s.client, req)
+}
+
+func (s *AuthService) UpdatePassword(ctx context.Context, password, newPassword string) (*Envelope[struct{}], error) {
+	req, err := s.client.NewRequest(http.MethodPut, "user/auth/update-password", &updatePasswordRequest{
+		Password:        password,
+		NewPassword:     newPassword,
+		ConfirmPassword: newPassword,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[struct{}](ctx, s.client, req)
+}
+
+func (s *AuthService) UpdateEmail(ctx context.Context, newEmail, password string) (*LocalAuthResponse, error) {
+	req, err := s.client.NewRequest(http.MethodPut, "user/auth/update-email", &updateEmailRequest{
+		NewEmail: newEmail,
+		Password: password,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*LocalAuth](ctx, s.client, req)
+}
+
+// UpdateAPIToken replaces the user's API token with a new one, which the
+// client uses from then on. The old token stops working, so the returned
+// credentials must be saved wherever the old ones were.
+//
+// The request is never retried: the server may have rotated the token before
+// failing, in which case a retry with the old token could only fail.
+func (s *AuthService) UpdateAPIToken(ctx context.Context) (*CredentialsResponse, error) {
+	req, err := s.client.NewRequest(http.MethodPut, "user/auth/update-api-token", nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	resp, err := do[*Credentials](withoutRetry(ctx), s.client, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Data != nil && resp.Data.APIToken != "" {
+		if resp.Data.ID == "" {
+			resp.Data.ID = s.client.userID
+		}
+		s.client.setToken(resp.Data.APIToken)
+	}
+	return resp, nil
+}
@@ -0,0 +1,245 @@
+package habitica_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	habitica "github.com/wfernandes/go-habitica"
+)
+
+func TestLogin_Auth(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	var body map[string]string
+	mux.HandleFunc("/user/auth/local/login", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(loginResponse)
+	})
+	unauthenticated, err := habitica.NewUnauthenticated(habitica.WithBaseURL(ts.URL))
+	Expect(err).ToNot(HaveOccurred())
+
+	resp, err := unauthenticated.Auth.Login(ctx, "someuser", "secret")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+	Expect(request.Header.Get("x-api-user")).To(BeEmpty())
+	Expect(request.Header.Get("x-api-key")).To(BeEmpty())
+	Expect(body).To(Equal(map[string]string{"username": "someuser", "password": "secret"}))
+
+	Expect(resp.Data.ID).To(Equal("b0413351-405f-416f-8787-947ec1c85199"))
+	Expect(resp.Data.APIToken).To(Equal("some-api-token"))
+	Expect(resp.Data.Username).To(Equal("someuser"))
+	Expect(resp.Data.NewUser).To(BeFalse())
+
+	_, err = habitica.New(resp.Data.ID, resp.Data.APIToken)
+	Expect(err).ToNot(HaveOccurred())
+}
+
+func TestLogin_AuthInvalidCredentials(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/auth/local/login", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"success":false,"error":"NotAuthorized","message":"Incorrect username and/or email and/or password."}`))
+	})
+	_, err := client.Auth.Login(ctx, "someuser", "wrong")
+	Expect(err).To(HaveOccurred())
+	Expect(err).To(MatchError(habitica.ErrUnauthorized))
+}
+
+func TestRegister_Auth(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	var body map[string]string
+	mux.HandleFunc("/user/auth/local/register", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		request = r
+		w.WriteHeader(http.StatusCreated)
+		w.Write(registerResponse)
+	})
+	resp, err := client.Auth.Register(ctx, "newuser", "new@example.com", "secret")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+	Expect(body).To(Equal(map[string]string{
+		"username":        "newuser",
+		"email":           "new@example.com",
+		"password":        "secret",
+		"confirmPassword": "secret",
+	}))
+
+	Expect(resp.Data.ID).To(Equal("3c2e5b3a-4f1d-4e8a-9b7c-1d2e3f4a5b6c"))
+	Expect(resp.Data.APIToken).To(Equal("new-api-token"))
+	Expect(resp.Data.NewUser).To(BeTrue())
+}
+
+func TestResetPassword_Auth(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	var body map[string]string
+	mux.HandleFunc("/user/reset-password", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success":true,"data":{},"message":"If the email is in our database, instructions have been sent."}`))
+	})
+	resp, err := client.Auth.ResetPassword(ctx, "someone@example.com")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+	Expect(body).To(Equal(map[string]string{"email": "someone@example.com"}))
+	Expect(resp.Success).To(BeTrue())
+}
+
+func TestUpdatePassword_Auth(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	var body map[string]string
+	mux.HandleFunc("/user/auth/update-password", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success":true,"data":{}}`))
+	})
+	_, err := client.Auth.UpdatePassword(ctx, "old", "new")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPut))
+	Expect(body).To(Equal(map[string]string{
+		"password":        "old",
+		"newPassword":     "new",
+		"confirmPassword": "new",
+	}))
+}
+
+func TestUpdateEmail_Auth(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	var body map[string]string
+	mux.HandleFunc("/user/auth/update-email", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success":true,"data":{"email":"new@example.com"}}`))
+	})
+	resp, err := client.Auth.UpdateEmail(ctx, "new@example.com", "secret")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPut))
+	Expect(body).To(Equal(map[string]string{"newEmail": "new@example.com", "password": "secret"}))
+	Expect(resp.Data.Email).To(Equal("new@example.com"))
+}
+
+func TestUpdateAPIToken_Auth(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/user/auth/update-api-token", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success":true,"data":{"apiToken":"rotated-api-token"}}`))
+	})
+	var userRequest *http.Request
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		userRequest = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(userResponse)
+	})
+
+	resp, err := client.Auth.UpdateAPIToken(ctx)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPut))
+	Expect(request.Header.Get("x-api-key")).To(Equal("api"))
+	Expect(resp.Data.ID).To(Equal("b0413351-405f-416f-8787-947ec1c85199"))
+	Expect(resp.Data.APIToken).To(Equal("rotated-api-token"))
+
+	_, err = client.User.Get(ctx)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(userRequest.Header.Get("x-api-key")).To(Equal("rotated-api-token"))
+}
+
+func TestUpdateAPIToken_AuthKeepsTokenOnError(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/auth/update-api-token", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"success":false,"error":"InternalServerError","message":"An unexpected error occurred."}`))
+	})
+	var userRequest *http.Request
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		userRequest = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(userResponse)
+	})
+
+	_, err := client.Auth.UpdateAPIToken(ctx)
+	Expect(err).To(HaveOccurred())
+
+	_, err = client.User.Get(ctx)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(userRequest.Header.Get("x-api-key")).To(Equal("api"))
+}
+
+func TestUpdateAPIToken_AuthIsNotRetried(t *testing.T) {
+	RegisterTestingT(t)
+
+	token := "api"
+	calls := 0
+	c, teardown := retryClient(2, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("x-api-key") != token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		// The token is rotated, but the response is lost.
+		token = "rotated-api-token"
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer teardown()
+
+	_, err := c.Auth.UpdateAPIToken(ctx)
+	Expect(err).To(MatchError(habitica.ErrServer))
+	Expect(calls).To(Equal(1))
+}
+
+var loginResponse = []byte(`{
+  "success": true,
+  "data": {
+    "id": "b0413351-405f-416f-8787-947ec1c85199",
+    "apiToken": "some-api-token",
+    "newUser": false,
+    "username": "someuser"
+  },
+  "appVersion": "5.20.0"
+}`)
+
+var registerResponse = []byte(`{
+  "success": true,
+  "data": {
+    "id": "3c2e5b3a-4f1d-4e8a-9b7c-1d2e3f4a5b6c",
+    "apiToken": "new-api-token",
+    "newUser": true
+  },
+  "appVersion": "5.20.0"
+}`)
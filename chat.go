@@ -0,0 +1,142 @@
+package habitica
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type ChatMessage struct {
+	ID        string          `json:"id"`
+	GroupID   string          `json:"groupId,omitempty"`
+	Text      string          `json:"text"`
+	Timestamp time.Time       `json:"timestamp"`
+	User      string          `json:"user,omitempty"`
+	Username  string          `json:"username,omitempty"`
+	UUID      string          `json:"uuid"`
+	Client    string          `json:"client,omitempty"`
+	Likes     map[string]bool `json:"likes,omitempty"`
+	Flags     map[string]bool `json:"flags,omitempty"`
+	FlagCount int             `json:"flagCount,omitempty"`
+}
+
+// UnmarshalJSON accepts the timestamp either as milliseconds since the
+// epoch or as an ISO 8601 string, as older messages use the former.
+func (m *ChatMessage) UnmarshalJSON(data []byte) error {
+	type message ChatMessage
+	var raw struct {
+		message
+		Timestamp json.RawMessage `json:"timestamp"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	*m = ChatMessage(raw.message)
+	return unmarshalTimestamp(raw.Timestamp, &m.Timestamp)
+}
+
+// PostChatResult holds the posted message, or all messages newer than
+// previousMsg if one was given to ChatService.Post.
+type PostChatResult struct {
+	Message *ChatMessage  `json:"message,omitempty"`
+	Chat    []ChatMessage `json:"chat,omitempty"`
+}
+
+type chatPostOptions struct {
+	PreviousMsg string `url:"previousMsg,omitempty"`
+}
+
+type ChatMessageResponse = Envelope[*ChatMessage]
+
+type ChatMessagesResponse = Envelope[[]ChatMessage]
+
+type PostChatResponse = Envelope[*PostChatResult]
+
+type ChatService struct {
+	client *HabiticaClient
+}
+
+func newChatService(h *HabiticaClient) *ChatService {
+	return &ChatService{
+		client: h,
+	}
+}
+
+func (s *ChatService) List(ctx context.Context, groupID string) (*ChatMessagesResponse, error) {
+	req, err := s.client.NewRequest(http.MethodGet, fmt.Sprintf("groups/%s/chat", groupID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[[]ChatMessage](ctx, s.client, req)
+}
+
+// Post posts a message to the group chat. If previousMsg is the id of the
+// last message the caller has seen, the response holds every message
+// posted after it instead of only the new one.
+func (s *ChatService) Post(ctx context.Context, groupID, message, previousMsg string) (*PostChatResponse, error) {
+	urlPath, err := addOptions(fmt.Sprintf("groups/%s/chat", groupID), &chatPostOptions{PreviousMsg: previousMsg})
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode options: %s", err)
+	}
+	body := map[string]string{"message": message}
+	req, err := s.client.NewRequest(http.MethodPost, urlPath, body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*PostChatResult](ctx, s.client, req)
+}
+
+// Like toggles the user's like on a message.
+func (s *ChatService) Like(ctx context.Context, groupID, chatID string) (*ChatMessageResponse, error) {
+	req, err := s.client.NewRequest(http.MethodPost, fmt.Sprintf("groups/%s/chat/%s/like", groupID, chatID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*ChatMessage](ctx, s.client, req)
+}
+
+// Flag reports a message to the moderators.
+func (s *ChatService) Flag(ctx context.Context, groupID, chatID string) (*ChatMessageResponse, error) {
+	req, err := s.client.NewRequest(http.MethodPost, fmt.Sprintf("groups/%s/chat/%s/flag", groupID, chatID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*ChatMessage](ctx, s.client, req)
+}
+
+// ClearFlags resets the flag count of a message. Only moderators can do so.
+func (s *ChatService) ClearFlags(ctx context.Context, groupID, chatID string) (*Envelope[struct{}], error) {
+	req, err := s.client.NewRequest(http.MethodPost, fmt.Sprintf("groups/%s/chat/%s/clearflags", groupID, chatID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[struct{}](ctx, s.client, req)
+}
+
+func (s *ChatService) Delete(ctx context.Context, groupID, chatID string) (*Envelope[struct{}], error) {
+	req, err := s.client.NewRequest(http.MethodDelete, fmt.Sprintf("groups/%s/chat/%s", groupID, chatID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[struct{}](ctx, s.client, req)
+}
+
+// MarkSeen marks all messages in the group chat as read.
+func (s *ChatService) MarkSeen(ctx context.Context, groupID string) (*Envelope[struct{}], error) {
+	req, err := s.client.NewRequest(http.MethodPost, fmt.Sprintf("groups/%s/chat/seen", groupID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[struct{}](ctx, s.client, req)
+}
@@ -0,0 +1,134 @@
+package habitica_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestList_Chat(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/groups/some-group-id/chat", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(chatMessagesResponse)
+	})
+	resp, err := client.Chat.List(ctx, "some-group-id")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodGet))
+	Expect(resp.Data).To(HaveLen(2))
+
+	msg := resp.Data[0]
+	Expect(msg.ID).To(Equal("chat-2"))
+	Expect(msg.Text).To(Equal("Build passed"))
+	Expect(msg.Username).To(Equal("ci-bot"))
+	Expect(msg.Timestamp).To(Equal(time.Date(2017, time.January, 14, 10, 0, 0, 0, time.UTC)))
+	Expect(msg.Likes).To(HaveKeyWithValue("b0413351-405f-416f-8787-947ec1c85199", true))
+	Expect(resp.Data[1].Timestamp.Equal(time.UnixMilli(1484388000000))).To(BeTrue())
+}
+
+func TestPost_Chat(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	var received map[string]string
+	mux.HandleFunc("/groups/some-group-id/chat", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&received)
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": {"message": {"id": "chat-3", "text": "Deploying", "timestamp": 1484388000000, "uuid": "b0413351-405f-416f-8787-947ec1c85199"}}}`))
+	})
+	resp, err := client.Chat.Post(ctx, "some-group-id", "Deploying", "")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+	Expect(request.URL.RawQuery).To(BeEmpty())
+	Expect(received).To(Equal(map[string]string{"message": "Deploying"}))
+	Expect(resp.Data.Message.ID).To(Equal("chat-3"))
+}
+
+func TestPost_ChatWithPreviousMsg(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/groups/some-group-id/chat", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": {"chat": [{"id": "chat-3", "text": "Deploying"}, {"id": "chat-2", "text": "Build passed"}]}}`))
+	})
+	resp, err := client.Chat.Post(ctx, "some-group-id", "Deploying", "chat-1")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.URL.Query().Get("previousMsg")).To(Equal("chat-1"))
+	Expect(resp.Data.Chat).To(HaveLen(2))
+}
+
+func TestChat_MessageActions(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	requests := []string{}
+	mux.HandleFunc("/groups/some-group-id/chat/", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": {"id": "chat-2", "text": "Build passed", "flagCount": 1}}`))
+	})
+
+	resp, err := client.Chat.Like(ctx, "some-group-id", "chat-2")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.Data.ID).To(Equal("chat-2"))
+	resp, err = client.Chat.Flag(ctx, "some-group-id", "chat-2")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.Data.FlagCount).To(Equal(1))
+	_, err = client.Chat.ClearFlags(ctx, "some-group-id", "chat-2")
+	Expect(err).ToNot(HaveOccurred())
+	_, err = client.Chat.Delete(ctx, "some-group-id", "chat-2")
+	Expect(err).ToNot(HaveOccurred())
+	_, err = client.Chat.MarkSeen(ctx, "some-group-id")
+	Expect(err).ToNot(HaveOccurred())
+
+	Expect(requests).To(Equal([]string{
+		"POST /groups/some-group-id/chat/chat-2/like",
+		"POST /groups/some-group-id/chat/chat-2/flag",
+		"POST /groups/some-group-id/chat/chat-2/clearflags",
+		"DELETE /groups/some-group-id/chat/chat-2",
+		"POST /groups/some-group-id/chat/seen",
+	}))
+}
+
+var chatMessagesResponse = []byte(`
+{
+    "success": true,
+    "data": [
+        {
+            "id": "chat-2",
+            "text": "Build passed",
+            "timestamp": "2017-01-14T10:00:00.000Z",
+            "user": "CI Bot",
+            "username": "ci-bot",
+            "uuid": "7a1b2c3d-0000-4000-8000-000000000001",
+            "likes": {
+                "b0413351-405f-416f-8787-947ec1c85199": true
+            },
+            "flags": {},
+            "flagCount": 0
+        },
+        {
+            "id": "chat-1",
+            "text": "Build started",
+            "timestamp": 1484388000000,
+            "uuid": "system"
+        }
+    ],
+    "notifications": []
+}`)
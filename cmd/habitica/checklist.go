@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/wfernandes/go-habitica"
+)
+
+func (c *cli) checklist(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New("missing checklist command: add")
+	}
+	if args[0] != "add" {
+		return fmt.Errorf("unknown checklist command %q", args[0])
+	}
+
+	fs := c.flagSet("checklist add")
+	args, err := parse(fs, args[1:])
+	if err != nil {
+		return err
+	}
+	if len(args) != 2 {
+		return errors.New("usage: checklist add <task-id> <text>")
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.Tasks.AddChecklistItem(ctx, args[0], &habitica.ChecklistItem{Text: args[1]})
+	if err != nil {
+		return err
+	}
+
+	t := &table{header: []string{"ID", "DONE", "TEXT"}}
+	for _, item := range resp.Data.Checklist {
+		t.add(item.Id, checkmark(item.Completed), item.Text)
+	}
+	return c.print(resp.Data.Checklist, t)
+}
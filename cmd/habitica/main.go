@@ -0,0 +1,173 @@
+// Command habitica manages Habitica tasks, tags and checklists from the
+// command line.
+//
+//	habitica tasks list --type dailys
+//	habitica tasks add "Write report" --type todo --tag work
+//	habitica tasks score <task-id> up
+//	habitica tags list|create|rename|delete
+//	habitica checklist add <task-id> "Socks"
+//
+// Credentials are read from the profile selected with --profile or
+// HABITICA_PROFILE in ~/.config/habitica/config.yaml, and may be overridden
+// with HABITICA_USER_ID and HABITICA_API_TOKEN.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/wfernandes/go-habitica"
+	"github.com/wfernandes/go-habitica/config"
+)
+
+const cliUserAgent = "go-habitica-cli/1"
+
+const usage = `usage: habitica [--profile name] [--output table|json|yaml] <command> [arguments]
+
+commands:
+  tasks list [--type habits|dailys|todos|rewards|completedTodos]
+  tasks add <text> [--type habit|daily|todo|reward] [--notes text] [--tag name]...
+  tasks score <task-id> up|down
+  tags list
+  tags create <name>
+  tags rename <tag-id> <name>
+  tags delete <tag-id>
+  checklist add <task-id> <text>
+`
+
+func main() {
+	cli := &cli{
+		stdout: os.Stdout,
+	}
+	cli.newClient = func() (*habitica.HabiticaClient, error) {
+		return newClient(cli.profile)
+	}
+	err := cli.run(context.Background(), os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "habitica: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+// newClient creates a client for the profile, as described by
+// config.LoadProfile.
+func newClient(profile string) (*habitica.HabiticaClient, error) {
+	p, err := config.LoadProfile(profile)
+	if err != nil {
+		return nil, err
+	}
+	client, err := habitica.NewFromProfile(p, habitica.WithUserAgent(cliUserAgent))
+	if errors.Is(err, habitica.ErrMissingCredentials) {
+		return nil, fmt.Errorf("%w: set HABITICA_USER_ID and HABITICA_API_TOKEN or add a profile to the config file", err)
+	}
+	return client, err
+}
+
+// cli holds what every command needs. newClient is only called by commands
+// that talk to the API, so usage errors are reported without credentials.
+type cli struct {
+	stdout    io.Writer
+	newClient func() (*habitica.HabiticaClient, error)
+	output    string
+	profile   string
+	client    *habitica.HabiticaClient
+}
+
+func (c *cli) run(ctx context.Context, args []string) error {
+	fs := c.flagSet("habitica")
+	err := fs.Parse(args)
+	if err != nil {
+		return err
+	}
+	args = fs.Args()
+	if len(args) == 0 {
+		fmt.Fprint(c.stdout, usage)
+		return errors.New("missing command")
+	}
+
+	switch args[0] {
+	case "tasks":
+		return c.tasks(ctx, args[1:])
+	case "tags":
+		return c.tags(ctx, args[1:])
+	case "checklist":
+		return c.checklist(ctx, args[1:])
+	case "help":
+		fmt.Fprint(c.stdout, usage)
+		return nil
+	}
+	return fmt.Errorf("unknown command %q", args[0])
+}
+
+// flagSet returns a flag set with the flags shared by every command.
+func (c *cli) flagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.stdout)
+	fs.Usage = func() {
+		fmt.Fprint(c.stdout, usage)
+	}
+	if c.output == "" {
+		c.output = "table"
+	}
+	fs.StringVar(&c.output, "output", c.output, "output format: table, json or yaml")
+	fs.StringVar(&c.output, "o", c.output, "shorthand for --output")
+	fs.StringVar(&c.profile, "profile", c.profile, "config profile to use")
+	return fs
+}
+
+// parse parses the flags wherever they appear among the positional
+// arguments, so that flags may follow them.
+func parse(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		err := fs.Parse(args)
+		if err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// api returns the client, creating it on first use. It is called once the
+// command's flags are parsed, so it also rejects an unknown output format
+// before anything is changed.
+func (c *cli) api() (*habitica.HabiticaClient, error) {
+	switch c.output {
+	case "table", "json", "yaml":
+	default:
+		return nil, fmt.Errorf("unknown output format %q", c.output)
+	}
+	if c.client != nil {
+		return c.client, nil
+	}
+	client, err := c.newClient()
+	if err != nil {
+		return nil, err
+	}
+	c.client = client
+	return client, nil
+}
+
+// stringsFlag is a flag that may be repeated.
+type stringsFlag []string
+
+func (s *stringsFlag) String() string {
+	return fmt.Sprint(*s)
+}
+
+func (s *stringsFlag) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
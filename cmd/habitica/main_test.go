@@ -0,0 +1,182 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/wfernandes/go-habitica"
+	"github.com/wfernandes/go-habitica/habiticatest"
+
+	. "github.com/onsi/gomega"
+)
+
+func newCLI(t *testing.T) (*cli, *habiticatest.Server, *bytes.Buffer) {
+	fake := habiticatest.NewServer()
+	t.Cleanup(fake.Close)
+	out := &bytes.Buffer{}
+	return &cli{
+		stdout: out,
+		newClient: func() (*habitica.HabiticaClient, error) {
+			return fake.Client()
+		},
+	}, fake, out
+}
+
+func TestTasks_ListTable(t *testing.T) {
+	RegisterTestingT(t)
+	c, fake, out := newCLI(t)
+	daily := fake.AddTask(habitica.Task{Text: "Stretch", Type: habitica.TaskTypeDaily, Completed: true})
+	fake.AddTask(habitica.Task{Text: "File taxes", Type: habitica.TaskTypeTodo})
+
+	err := c.run(context.Background(), []string{"tasks", "list", "--type", "dailys"})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(out.String()).To(Equal(
+		"ID                                    TYPE   DONE  TEXT\n" +
+			daily.ID + "  daily  x     Stretch\n"))
+}
+
+func TestTasks_ListJSON(t *testing.T) {
+	RegisterTestingT(t)
+	c, fake, out := newCLI(t)
+	fake.AddTask(habitica.Task{Text: "File taxes", Type: habitica.TaskTypeTodo})
+
+	err := c.run(context.Background(), []string{"--output", "json", "tasks", "list"})
+	Expect(err).ToNot(HaveOccurred())
+	var tasks []habitica.Task
+	Expect(json.Unmarshal(out.Bytes(), &tasks)).To(Succeed())
+	Expect(tasks).To(HaveLen(1))
+	Expect(tasks[0].Text).To(Equal("File taxes"))
+}
+
+func TestTasks_ListYAML(t *testing.T) {
+	RegisterTestingT(t)
+	c, fake, out := newCLI(t)
+	tag := fake.AddTag("work")
+
+	err := c.run(context.Background(), []string{"tags", "list", "-o", "yaml"})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(out.String()).To(Equal("- id: " + tag.ID + "\n  name: work\n"))
+}
+
+func TestTasks_Add(t *testing.T) {
+	RegisterTestingT(t)
+	c, fake, out := newCLI(t)
+	work := fake.AddTag("work")
+
+	err := c.run(context.Background(), []string{"tasks", "add", "Write report", "--type", "todo", "--tag", "work", "--notes", "Due Friday"})
+	Expect(err).ToNot(HaveOccurred())
+	tasks := fake.Tasks()
+	Expect(tasks).To(HaveLen(1))
+	Expect(tasks[0].Text).To(Equal("Write report"))
+	Expect(tasks[0].Type).To(Equal(habitica.TaskTypeTodo))
+	Expect(tasks[0].Notes).To(Equal("Due Friday"))
+	Expect(tasks[0].Tags).To(Equal([]string{work.ID}))
+	Expect(out.String()).To(ContainSubstring(tasks[0].ID))
+
+	err = c.run(context.Background(), []string{"tasks", "add", "Other", "--tag", "missing"})
+	Expect(err).To(MatchError(`unknown tag "missing"`))
+}
+
+func TestTasks_Score(t *testing.T) {
+	RegisterTestingT(t)
+	c, fake, out := newCLI(t)
+	todo := fake.AddTask(habitica.Task{Text: "Write report", Type: habitica.TaskTypeTodo})
+
+	err := c.run(context.Background(), []string{"tasks", "score", todo.ID, "up"})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(out.String()).To(ContainSubstring("DELTA"))
+	got, _ := fake.Task(todo.ID)
+	Expect(got.Completed).To(BeTrue())
+
+	err = c.run(context.Background(), []string{"tasks", "score", todo.ID, "sideways"})
+	Expect(err).To(HaveOccurred())
+}
+
+func TestTags_Commands(t *testing.T) {
+	RegisterTestingT(t)
+	c, fake, _ := newCLI(t)
+
+	err := c.run(context.Background(), []string{"tags", "create", "work"})
+	Expect(err).ToNot(HaveOccurred())
+	tags := fake.Tags()
+	Expect(tags).To(HaveLen(1))
+
+	err = c.run(context.Background(), []string{"tags", "rename", tags[0].ID, "office"})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(fake.Tags()[0].Name).To(Equal("office"))
+
+	err = c.run(context.Background(), []string{"tags", "delete", tags[0].ID})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(fake.Tags()).To(BeEmpty())
+}
+
+func TestChecklist_Add(t *testing.T) {
+	RegisterTestingT(t)
+	c, fake, out := newCLI(t)
+	todo := fake.AddTask(habitica.Task{Text: "Pack", Type: habitica.TaskTypeTodo})
+
+	err := c.run(context.Background(), []string{"checklist", "add", todo.ID, "Socks"})
+	Expect(err).ToNot(HaveOccurred())
+	got, _ := fake.Task(todo.ID)
+	Expect(got.Checklist).To(HaveLen(1))
+	Expect(got.Checklist[0].Text).To(Equal("Socks"))
+	Expect(out.String()).To(ContainSubstring("Socks"))
+}
+
+func TestUsageErrors(t *testing.T) {
+	RegisterTestingT(t)
+	c := &cli{
+		stdout: &bytes.Buffer{},
+		newClient: func() (*habitica.HabiticaClient, error) {
+			panic("usage errors must not need credentials")
+		},
+	}
+
+	Expect(c.run(context.Background(), nil)).To(MatchError("missing command"))
+	Expect(c.run(context.Background(), []string{"bogus"})).To(MatchError(`unknown command "bogus"`))
+	Expect(c.run(context.Background(), []string{"tasks", "score", "only-id"})).To(HaveOccurred())
+	Expect(c.run(context.Background(), []string{"tags", "rename", "only-id"})).To(HaveOccurred())
+	Expect(c.run(context.Background(), []string{"checklist", "remove"})).To(HaveOccurred())
+	Expect(c.run(context.Background(), []string{"tags", "create", "work", "-o", "xml"})).To(MatchError(`unknown output format "xml"`))
+}
+
+func TestNewClient_Profile(t *testing.T) {
+	RegisterTestingT(t)
+	fake := habiticatest.NewServer()
+	defer fake.Close()
+
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("HABITICA_USER_ID", "")
+	t.Setenv("HABITICA_API_TOKEN", "")
+	t.Setenv("HABITICA_PROFILE", "")
+	path := filepath.Join(dir, "habitica", "config.yaml")
+	Expect(os.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+	Expect(os.WriteFile(path, []byte(`
+profiles:
+  fake:
+    user_id: `+fake.UserID+`
+    api_token: `+fake.APIToken+`
+    base_url: `+fake.URL+`
+`), 0o600)).To(Succeed())
+	fake.AddTag("work")
+
+	out := &bytes.Buffer{}
+	c := &cli{stdout: out}
+	c.newClient = func() (*habitica.HabiticaClient, error) {
+		return newClient(c.profile)
+	}
+	err := c.run(context.Background(), []string{"--profile", "fake", "tags", "list"})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(out.String()).To(ContainSubstring("work"))
+
+	_, err = newClient("")
+	Expect(errors.Is(err, habitica.ErrMissingCredentials)).To(BeTrue())
+	_, err = newClient("missing")
+	Expect(err).To(MatchError(`unknown profile "missing"`))
+}
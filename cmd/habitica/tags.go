@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/wfernandes/go-habitica"
+)
+
+func (c *cli) tags(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New("missing tags command: list, create, rename or delete")
+	}
+	fs := c.flagSet("tags " + args[0])
+	rest, err := parse(fs, args[1:])
+	if err != nil {
+		return err
+	}
+
+	var want int
+	switch args[0] {
+	case "list":
+		want = 0
+	case "create", "delete":
+		want = 1
+	case "rename":
+		want = 2
+	default:
+		return fmt.Errorf("unknown tags command %q", args[0])
+	}
+	if len(rest) != want {
+		return fmt.Errorf("usage: %s", tagsUsage[args[0]])
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	switch args[0] {
+	case "list":
+		resp, err := client.Tags.List(ctx)
+		if err != nil {
+			return err
+		}
+		return c.print(resp.Data, tagsTable(resp.Data...))
+	case "create":
+		resp, err := client.Tags.Create(ctx, &habitica.Tag{Name: rest[0]})
+		if err != nil {
+			return err
+		}
+		return c.print(resp.Data, tagsTable(*resp.Data))
+	case "rename":
+		resp, err := client.Tags.Update(ctx, rest[0], &habitica.Tag{Name: rest[1]})
+		if err != nil {
+			return err
+		}
+		return c.print(resp.Data, tagsTable(*resp.Data))
+	default:
+		_, err := client.Tags.Delete(ctx, rest[0])
+		return err
+	}
+}
+
+var tagsUsage = map[string]string{
+	"list":   "tags list",
+	"create": "tags create <name>",
+	"rename": "tags rename <tag-id> <name>",
+	"delete": "tags delete <tag-id>",
+}
+
+func tagsTable(tags ...habitica.Tag) *table {
+	t := &table{header: []string{"ID", "NAME"}}
+	for _, tag := range tags {
+		t.add(tag.ID, tag.Name)
+	}
+	return t
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/wfernandes/go-habitica"
+)
+
+func (c *cli) tasks(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New("missing tasks command: list, add or score")
+	}
+	switch args[0] {
+	case "list":
+		return c.listTasks(ctx, args[1:])
+	case "add":
+		return c.addTask(ctx, args[1:])
+	case "score":
+		return c.scoreTask(ctx, args[1:])
+	}
+	return fmt.Errorf("unknown tasks command %q", args[0])
+}
+
+func (c *cli) listTasks(ctx context.Context, args []string) error {
+	fs := c.flagSet("tasks list")
+	listType := fs.String("type", "", "habits, dailys, todos, rewards or completedTodos")
+	args, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) != 0 {
+		return errors.New("usage: tasks list [--type type]")
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	var opts *habitica.TaskListOptions
+	if *listType != "" {
+		opts = &habitica.TaskListOptions{Type: habitica.TaskListType(*listType)}
+	}
+	resp, err := client.Tasks.List(ctx, opts)
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Data, tasksTable(resp.Data...))
+}
+
+func (c *cli) addTask(ctx context.Context, args []string) error {
+	fs := c.flagSet("tasks add")
+	taskType := fs.String("type", string(habitica.TaskTypeTodo), "habit, daily, todo or reward")
+	notes := fs.String("notes", "", "notes for the task")
+	var tags stringsFlag
+	fs.Var(&tags, "tag", "tag name or id, may be repeated")
+	args, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) != 1 {
+		return errors.New("usage: tasks add <text> [--type type] [--notes text] [--tag name]...")
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	tagIDs, err := resolveTags(ctx, client, tags)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Tasks.Create(ctx, &habitica.Task{
+		Text:  args[0],
+		Type:  habitica.TaskType(*taskType),
+		Notes: *notes,
+		Tags:  tagIDs,
+	})
+	if err != nil {
+		return err
+	}
+	return c.print(resp.Data, tasksTable(*resp.Data))
+}
+
+// resolveTags turns tag names into ids. Arguments that already are tag ids
+// are kept as they are.
+func resolveTags(ctx context.Context, client *habitica.HabiticaClient, names []string) ([]string, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	resp, err := client.Tags.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		id := ""
+		for _, tag := range resp.Data {
+			if tag.Name == name || tag.ID == name {
+				id = tag.ID
+				break
+			}
+		}
+		if id == "" {
+			return nil, fmt.Errorf("unknown tag %q", name)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (c *cli) scoreTask(ctx context.Context, args []string) error {
+	fs := c.flagSet("tasks score")
+	args, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) != 2 {
+		return errors.New("usage: tasks score <task-id> up|down")
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	resp, err := client.Tasks.Score(ctx, args[0], habitica.ScoreDirection(args[1]))
+	if err != nil {
+		return err
+	}
+
+	s := resp.Data
+	t := &table{header: []string{"DELTA", "HP", "MP", "EXP", "GP", "LVL"}}
+	t.add(formatFloat(s.Delta), formatFloat(s.HP), formatFloat(s.MP), formatFloat(s.Exp), formatFloat(s.GP), strconv.Itoa(s.Lvl))
+	return c.print(s, t)
+}
+
+func tasksTable(tasks ...habitica.Task) *table {
+	t := &table{header: []string{"ID", "TYPE", "DONE", "TEXT"}}
+	for _, task := range tasks {
+		t.add(task.ID, string(task.Type), checkmark(task.Completed), task.Text)
+	}
+	return t
+}
+
+func checkmark(done bool) string {
+	if done {
+		return "x"
+	}
+	return ""
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
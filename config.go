@@ -0,0 +1,73 @@
+package habitica
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+// ErrMissingCredentials is returned when a profile has no user id or API
+// token.
+var ErrMissingCredentials = errors.New("habitica: missing user id or api token")
+
+// ConfigProfile holds the settings for one account. The config package
+// loads profiles from a file.
+type ConfigProfile struct {
+	UserID   string `yaml:"user_id"`
+	APIToken string `yaml:"api_token"`
+	BaseURL  string `yaml:"base_url,omitempty"`
+
+	// XClient is the x-client header value: the author's user id and the
+	// app name joined by a dash.
+	XClient string        `yaml:"x_client,omitempty"`
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+// NewFromEnv returns a client for the credentials in HABITICA_USER_ID and
+// HABITICA_API_TOKEN. It does not read the config file, so it fails if
+// HABITICA_PROFILE selects a profile; use config.NewFromEnv for profiles.
+func NewFromEnv(opts ...ClientOpt) (*HabiticaClient, error) {
+	if name := os.Getenv("HABITICA_PROFILE"); name != "" {
+		return nil, fmt.Errorf("HABITICA_PROFILE is set to %q: use config.NewFromEnv to load profiles from the config file", name)
+	}
+	return NewFromProfile(ConfigProfile{
+		UserID:   os.Getenv("HABITICA_USER_ID"),
+		APIToken: os.Getenv("HABITICA_API_TOKEN"),
+	}, opts...)
+}
+
+// NewFromProfile returns a client configured by the profile. The options
+// are applied after the profile's settings and take precedence over them.
+func NewFromProfile(p ConfigProfile, opts ...ClientOpt) (*HabiticaClient, error) {
+	if p.UserID == "" || p.APIToken == "" {
+		return nil, ErrMissingCredentials
+	}
+
+	var profileOpts []ClientOpt
+	if p.BaseURL != "" {
+		profileOpts = append(profileOpts, WithBaseURL(p.BaseURL))
+	}
+	if p.XClient != "" {
+		author, app, err := splitXClient(p.XClient)
+		if err != nil {
+			return nil, err
+		}
+		profileOpts = append(profileOpts, WithXClient(author, app))
+	}
+	if p.Timeout > 0 {
+		profileOpts = append(profileOpts, WithHttpClient(&http.Client{Timeout: p.Timeout}))
+	}
+	return New(p.UserID, p.APIToken, append(profileOpts, opts...)...)
+}
+
+// splitXClient splits an x-client value into the author's user id, which is
+// a uuid of 36 characters, and the app name.
+func splitXClient(xClient string) (string, string, error) {
+	const uuidLen = 36
+	if len(xClient) < uuidLen+2 || xClient[uuidLen] != '-' {
+		return "", "", fmt.Errorf("x-client must be <author user id>-<app name>: %q", xClient)
+	}
+	return xClient[:uuidLen], xClient[uuidLen+1:], nil
+}
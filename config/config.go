@@ -0,0 +1,121 @@
+// Package config loads Habitica credentials and client settings from a
+// config file holding named profiles:
+//
+//	default_profile: personal
+//	profiles:
+//	  personal:
+//	    user_id: b0413351-405f-416f-8787-947ec1c85199
+//	    api_token: some-api-token
+//	  bot:
+//	    user_id: 3c2e5b3a-4f1d-4e8a-9b7c-1d2e3f4a5b6c
+//	    api_token: another-api-token
+//	    x_client: b0413351-405f-416f-8787-947ec1c85199-my-bot
+//	    timeout: 30s
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/wfernandes/go-habitica"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the contents of the config file.
+type Config struct {
+	DefaultProfile string                            `yaml:"default_profile,omitempty"`
+	Profiles       map[string]habitica.ConfigProfile `yaml:"profiles"`
+}
+
+// DefaultPath returns $XDG_CONFIG_HOME/habitica/config.yaml, or
+// ~/.config/habitica/config.yaml if XDG_CONFIG_HOME is not set.
+func DefaultPath() (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "habitica", "config.yaml"), nil
+}
+
+// Load reads the config file at path. A missing file is not an error and
+// results in an empty config.
+func Load(path string) (*Config, error) {
+	cfg := &Config{}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = yaml.Unmarshal(data, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %s", path, err)
+	}
+	return cfg, nil
+}
+
+// Profile returns the named profile. An empty name selects the default
+// profile, or the one named "default". Selecting no profile in a config
+// without one returns an empty profile.
+func (c *Config) Profile(name string) (habitica.ConfigProfile, error) {
+	explicit := name != ""
+	if !explicit {
+		name = c.DefaultProfile
+		explicit = name != ""
+	}
+	if name == "" {
+		name = "default"
+	}
+	p, ok := c.Profiles[name]
+	if !ok && explicit {
+		return habitica.ConfigProfile{}, fmt.Errorf("unknown profile %q", name)
+	}
+	return p, nil
+}
+
+// LoadProfile returns the named profile from the default config file, with
+// the user id and API token overridden by HABITICA_USER_ID and
+// HABITICA_API_TOKEN when they are set. An empty name selects the profile in
+// HABITICA_PROFILE, then the config's default profile.
+func LoadProfile(name string) (habitica.ConfigProfile, error) {
+	path, err := DefaultPath()
+	if err != nil {
+		return habitica.ConfigProfile{}, err
+	}
+	cfg, err := Load(path)
+	if err != nil {
+		return habitica.ConfigProfile{}, err
+	}
+	if name == "" {
+		name = os.Getenv("HABITICA_PROFILE")
+	}
+	p, err := cfg.Profile(name)
+	if err != nil {
+		return habitica.ConfigProfile{}, err
+	}
+
+	if v := os.Getenv("HABITICA_USER_ID"); v != "" {
+		p.UserID = v
+	}
+	if v := os.Getenv("HABITICA_API_TOKEN"); v != "" {
+		p.APIToken = v
+	}
+	return p, nil
+}
+
+// NewFromEnv returns a client for the profile selected by the environment,
+// as described by LoadProfile.
+func NewFromEnv(opts ...habitica.ClientOpt) (*habitica.HabiticaClient, error) {
+	p, err := LoadProfile("")
+	if err != nil {
+		return nil, err
+	}
+	return habitica.NewFromProfile(p, opts...)
+}
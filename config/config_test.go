@@ -0,0 +1,141 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/wfernandes/go-habitica"
+	"github.com/wfernandes/go-habitica/config"
+
+	. "github.com/onsi/gomega"
+)
+
+const testConfig = `
+default_profile: personal
+profiles:
+  personal:
+    user_id: b0413351-405f-416f-8787-947ec1c85199
+    api_token: personal-token
+  bot:
+    user_id: 3c2e5b3a-4f1d-4e8a-9b7c-1d2e3f4a5b6c
+    api_token: bot-token
+    base_url: http://localhost:3000/api/v3
+    x_client: b0413351-405f-416f-8787-947ec1c85199-my-bot
+    timeout: 30s
+`
+
+// writeConfig writes the config file to a temporary XDG_CONFIG_HOME and
+// clears the credential environment variables.
+func writeConfig(t *testing.T, content string) string {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("HABITICA_USER_ID", "")
+	t.Setenv("HABITICA_API_TOKEN", "")
+	t.Setenv("HABITICA_PROFILE", "")
+	if content == "" {
+		return dir
+	}
+	path := filepath.Join(dir, "habitica", "config.yaml")
+	Expect(os.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+	Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+	return dir
+}
+
+func TestDefaultPath(t *testing.T) {
+	RegisterTestingT(t)
+	dir := writeConfig(t, "")
+
+	path, err := config.DefaultPath()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(path).To(Equal(filepath.Join(dir, "habitica", "config.yaml")))
+
+	t.Setenv("XDG_CONFIG_HOME", "")
+	t.Setenv("HOME", "/home/someone")
+	path, err = config.DefaultPath()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(path).To(Equal("/home/someone/.config/habitica/config.yaml"))
+}
+
+func TestLoad(t *testing.T) {
+	RegisterTestingT(t)
+	dir := writeConfig(t, testConfig)
+
+	cfg, err := config.Load(filepath.Join(dir, "habitica", "config.yaml"))
+	Expect(err).ToNot(HaveOccurred())
+	Expect(cfg.DefaultProfile).To(Equal("personal"))
+	Expect(cfg.Profiles).To(HaveLen(2))
+	Expect(cfg.Profiles["bot"].Timeout).To(Equal(30 * time.Second))
+
+	p, err := cfg.Profile("")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(p.APIToken).To(Equal("personal-token"))
+
+	p, err = cfg.Profile("bot")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(p.APIToken).To(Equal("bot-token"))
+
+	_, err = cfg.Profile("missing")
+	Expect(err).To(MatchError(`unknown profile "missing"`))
+
+	cfg, err = config.Load(filepath.Join(dir, "missing.yaml"))
+	Expect(err).ToNot(HaveOccurred())
+	p, err = cfg.Profile("")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(p).To(Equal(habitica.ConfigProfile{}))
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	RegisterTestingT(t)
+	dir := writeConfig(t, "profiles: [not, a, map]")
+
+	_, err := config.Load(filepath.Join(dir, "habitica", "config.yaml"))
+	Expect(err).To(HaveOccurred())
+}
+
+func TestLoadProfile_Environment(t *testing.T) {
+	RegisterTestingT(t)
+	writeConfig(t, testConfig)
+
+	p, err := config.LoadProfile("")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(p.APIToken).To(Equal("personal-token"))
+
+	t.Setenv("HABITICA_PROFILE", "bot")
+	p, err = config.LoadProfile("")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(p.APIToken).To(Equal("bot-token"))
+
+	p, err = config.LoadProfile("personal")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(p.APIToken).To(Equal("personal-token"))
+
+	t.Setenv("HABITICA_API_TOKEN", "env-token")
+	p, err = config.LoadProfile("")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(p.UserID).To(Equal("3c2e5b3a-4f1d-4e8a-9b7c-1d2e3f4a5b6c"))
+	Expect(p.APIToken).To(Equal("env-token"))
+	Expect(p.BaseURL).To(Equal("http://localhost:3000/api/v3"))
+}
+
+func TestNewFromEnv(t *testing.T) {
+	RegisterTestingT(t)
+	writeConfig(t, testConfig)
+	t.Setenv("HABITICA_PROFILE", "bot")
+
+	c, err := config.NewFromEnv()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(c.BaseURL).To(Equal("http://localhost:3000/api/v3"))
+	Expect(c.XClient()).To(Equal("b0413351-405f-416f-8787-947ec1c85199-my-bot"))
+	Expect(c.Client.Timeout).To(Equal(30 * time.Second))
+}
+
+func TestNewFromEnv_UnknownProfile(t *testing.T) {
+	RegisterTestingT(t)
+	writeConfig(t, testConfig)
+	t.Setenv("HABITICA_PROFILE", "missing")
+
+	_, err := config.NewFromEnv()
+	Expect(err).To(MatchError(`unknown profile "missing"`))
+}
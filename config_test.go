@@ -0,0 +1,78 @@
+package habitica_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/wfernandes/go-habitica"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestNewFromEnv(t *testing.T) {
+	RegisterTestingT(t)
+	t.Setenv("HABITICA_USER_ID", "")
+	t.Setenv("HABITICA_API_TOKEN", "")
+	t.Setenv("HABITICA_PROFILE", "")
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(userResponse)
+	})
+
+	_, err := habitica.NewFromEnv()
+	Expect(errors.Is(err, habitica.ErrMissingCredentials)).To(BeTrue())
+
+	t.Setenv("HABITICA_USER_ID", "b0413351-405f-416f-8787-947ec1c85199")
+	t.Setenv("HABITICA_API_TOKEN", "env-token")
+	c, err := habitica.NewFromEnv(habitica.WithBaseURL(ts.URL))
+	Expect(err).ToNot(HaveOccurred())
+	_, err = c.User.Get(ctx)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Header.Get("x-api-user")).To(Equal("b0413351-405f-416f-8787-947ec1c85199"))
+	Expect(request.Header.Get("x-api-key")).To(Equal("env-token"))
+}
+
+func TestNewFromEnv_Profile(t *testing.T) {
+	RegisterTestingT(t)
+	t.Setenv("HABITICA_USER_ID", "b0413351-405f-416f-8787-947ec1c85199")
+	t.Setenv("HABITICA_API_TOKEN", "env-token")
+	t.Setenv("HABITICA_PROFILE", "bot")
+
+	_, err := habitica.NewFromEnv()
+	Expect(err).To(MatchError(ContainSubstring("config.NewFromEnv")))
+	Expect(errors.Is(err, habitica.ErrMissingCredentials)).To(BeFalse())
+}
+
+func TestNewFromProfile(t *testing.T) {
+	RegisterTestingT(t)
+
+	c, err := habitica.NewFromProfile(habitica.ConfigProfile{
+		UserID:   "3c2e5b3a-4f1d-4e8a-9b7c-1d2e3f4a5b6c",
+		APIToken: "bot-token",
+		BaseURL:  "http://localhost:3000/api/v3",
+		XClient:  "b0413351-405f-416f-8787-947ec1c85199-my-bot",
+		Timeout:  30 * time.Second,
+	})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(c.BaseURL).To(Equal("http://localhost:3000/api/v3"))
+	Expect(c.XClient()).To(Equal("b0413351-405f-416f-8787-947ec1c85199-my-bot"))
+	Expect(c.Client.Timeout).To(Equal(30 * time.Second))
+}
+
+func TestNewFromProfile_InvalidXClient(t *testing.T) {
+	RegisterTestingT(t)
+
+	_, err := habitica.NewFromProfile(habitica.ConfigProfile{
+		UserID:   "b0413351-405f-416f-8787-947ec1c85199",
+		APIToken: "api",
+		XClient:  "my-bot",
+	})
+	Expect(err).To(HaveOccurred())
+}
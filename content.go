@@ -0,0 +1,178 @@
+package habitica
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Content is the game content: the names, prices and stats of everything in
+// Habitica. Text fields are in the language the content was requested in.
+type Content struct {
+	Gear            ContentGear               `json:"gear"`
+	Eggs            map[string]Egg            `json:"eggs"`
+	HatchingPotions map[string]HatchingPotion `json:"hatchingPotions"`
+	Food            map[string]Food           `json:"food"`
+	Quests          map[string]Quest          `json:"quests"`
+
+	// Spells is keyed by class and then by skill.
+	Spells map[Class]map[Skill]Spell `json:"spells"`
+}
+
+type ContentGear struct {
+	// Flat holds every piece of gear keyed by its key.
+	Flat map[string]GearItem `json:"flat"`
+}
+
+type Egg struct {
+	Key       string  `json:"key"`
+	Text      string  `json:"text"`
+	Adjective string  `json:"adjective,omitempty"`
+	Notes     string  `json:"notes,omitempty"`
+	Value     float64 `json:"value"`
+}
+
+type HatchingPotion struct {
+	Key     string  `json:"key"`
+	Text    string  `json:"text"`
+	Notes   string  `json:"notes,omitempty"`
+	Value   float64 `json:"value"`
+	Premium bool    `json:"premium,omitempty"`
+	Limited bool    `json:"limited,omitempty"`
+}
+
+type Food struct {
+	Key    string  `json:"key"`
+	Text   string  `json:"text"`
+	Notes  string  `json:"notes,omitempty"`
+	Value  float64 `json:"value"`
+	Target string  `json:"target,omitempty"`
+}
+
+type Quest struct {
+	Key      string                  `json:"key"`
+	Text     string                  `json:"text"`
+	Notes    string                  `json:"notes,omitempty"`
+	Category string                  `json:"category,omitempty"`
+	Value    float64                 `json:"value"`
+	Boss     *QuestBoss              `json:"boss,omitempty"`
+	Collect  map[string]QuestCollect `json:"collect,omitempty"`
+	Drop     QuestDrop               `json:"drop"`
+}
+
+type QuestBoss struct {
+	Name string  `json:"name"`
+	HP   float64 `json:"hp"`
+	Str  float64 `json:"str"`
+}
+
+type QuestCollect struct {
+	Text  string `json:"text"`
+	Count int    `json:"count"`
+}
+
+type QuestDrop struct {
+	GP  float64 `json:"gp"`
+	Exp float64 `json:"exp"`
+}
+
+type Spell struct {
+	Key    Skill      `json:"key"`
+	Text   string     `json:"text"`
+	Notes  string     `json:"notes,omitempty"`
+	Mana   float64    `json:"mana"`
+	Lvl    int        `json:"lvl,omitempty"`
+	Target TargetType `json:"target"`
+}
+
+// Spell looks up a skill across all classes.
+func (c *Content) Spell(skill Skill) (Spell, bool) {
+	for _, spells := range c.Spells {
+		if s, ok := spells[skill]; ok {
+			return s, true
+		}
+	}
+	return Spell{}, false
+}
+
+type ContentResponse = Envelope[*Content]
+
+type contentOptions struct {
+	Language string `url:"language,omitempty"`
+}
+
+type ContentService struct {
+	client *HabiticaClient
+}
+
+func newContentService(h *HabiticaClient) *ContentService {
+	return &ContentService{
+		client: h,
+	}
+}
+
+// Get returns the game content in the given language, or the user's
+// language if empty.
+//
+// Responses are stored in the client's ContentCache. A cached entry is
+// revalidated with its ETag and reused while the server reports it as not
+// modified, unless the client has since seen a different app version.
+func (s *ContentService) Get(ctx context.Context, language string) (*ContentResponse, error) {
+	urlPath, err := addOptions("content", &contentOptions{Language: language})
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode options: %s", err)
+	}
+	req, err := s.client.NewRequest(http.MethodGet, urlPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	cache := s.client.contentCache
+	cached, err := cache.Get(language)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read content cache: %s", err)
+	}
+	if cached != nil && cached.ETag != "" {
+		if v := s.client.AppVersion(); v == "" || v == cached.AppVersion {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+	}
+
+	var buf bytes.Buffer
+	resp, err := s.client.Do(ctx, req, &buf)
+	var errResp *ErrorResponse
+	if errors.As(err, &errResp) && errResp.StatusCode == http.StatusNotModified && cached != nil {
+		return decodeContent(cached.Body, resp)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := decodeContent(buf.Bytes(), resp)
+	if err != nil {
+		return nil, err
+	}
+	s.client.setAppVersion(content.AppVersion)
+	err = cache.Set(language, &CachedContent{
+		AppVersion: content.AppVersion,
+		ETag:       resp.Header.Get("ETag"),
+		Body:       buf.Bytes(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to write content cache: %s", err)
+	}
+	return content, nil
+}
+
+func decodeContent(body []byte, resp *Response) (*ContentResponse, error) {
+	content := new(ContentResponse)
+	err := json.Unmarshal(body, content)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode response body: %w", err)
+	}
+	content.Response = resp
+	return content, nil
+}
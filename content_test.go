@@ -0,0 +1,195 @@
+package habitica_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/wfernandes/go-habitica"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestGet_Content(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/content", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(contentResponse)
+	})
+	resp, err := client.Content.Get(ctx, "de")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodGet))
+	Expect(request.URL.Query().Get("language")).To(Equal("de"))
+	Expect(resp.AppVersion).To(Equal("5.1.0"))
+
+	content := resp.Data
+	Expect(content.Gear.Flat["weapon_warrior_1"].Text).To(Equal("Training Sword"))
+	Expect(content.Gear.Flat["weapon_warrior_1"].Str).To(Equal(3.0))
+	Expect(content.Eggs["Wolf"].Adjective).To(Equal("loyal"))
+	Expect(content.HatchingPotions["Base"].Value).To(Equal(2.0))
+	Expect(content.Food["Meat"].Target).To(Equal("Base"))
+	Expect(content.Quests["dustbunnies"].Boss.HP).To(Equal(100.0))
+	Expect(content.Quests["dustbunnies"].Drop.GP).To(Equal(8.0))
+	Expect(content.Spells[habitica.ClassMage][habitica.SkillFireball].Mana).To(Equal(10.0))
+
+	spell, ok := content.Spell(habitica.SkillFireball)
+	Expect(ok).To(BeTrue())
+	Expect(spell.Target).To(Equal(habitica.TargetTask))
+	_, ok = content.Spell(habitica.SkillHeal)
+	Expect(ok).To(BeFalse())
+}
+
+func TestGet_ContentRevalidates(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	var ifNoneMatch []string
+	mux.HandleFunc("/content", func(w http.ResponseWriter, r *http.Request) {
+		ifNoneMatch = append(ifNoneMatch, r.Header.Get("If-None-Match"))
+		if r.Header.Get("If-None-Match") == `W/"abc"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `W/"abc"`)
+		w.WriteHeader(http.StatusOK)
+		w.Write(contentResponse)
+	})
+
+	_, err := client.Content.Get(ctx, "")
+	Expect(err).ToNot(HaveOccurred())
+	resp, err := client.Content.Get(ctx, "")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(ifNoneMatch).To(Equal([]string{"", `W/"abc"`}))
+	Expect(resp.Response.StatusCode).To(Equal(http.StatusNotModified))
+	Expect(resp.Data.Eggs["Wolf"].Text).To(Equal("Wolf"))
+}
+
+func TestGet_ContentRefetchesOnNewAppVersion(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	var ifNoneMatch []string
+	mux.HandleFunc("/content", func(w http.ResponseWriter, r *http.Request) {
+		ifNoneMatch = append(ifNoneMatch, r.Header.Get("If-None-Match"))
+		w.Header().Set("ETag", `W/"abc"`)
+		w.WriteHeader(http.StatusOK)
+		w.Write(contentResponse)
+	})
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": {}, "appVersion": "5.2.0"}`))
+	})
+
+	_, err := client.Content.Get(ctx, "en")
+	Expect(err).ToNot(HaveOccurred())
+	_, err = client.User.Get(ctx)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(client.AppVersion()).To(Equal("5.2.0"))
+
+	_, err = client.Content.Get(ctx, "en")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(ifNoneMatch).To(Equal([]string{"", ""}))
+}
+
+func TestGet_ContentCachedPerLanguage(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	var ifNoneMatch []string
+	mux.HandleFunc("/content", func(w http.ResponseWriter, r *http.Request) {
+		ifNoneMatch = append(ifNoneMatch, r.Header.Get("If-None-Match"))
+		w.Header().Set("ETag", `W/"abc"`)
+		w.WriteHeader(http.StatusOK)
+		w.Write(contentResponse)
+	})
+
+	_, err := client.Content.Get(ctx, "en")
+	Expect(err).ToNot(HaveOccurred())
+	_, err = client.Content.Get(ctx, "de")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(ifNoneMatch).To(Equal([]string{"", ""}))
+}
+
+var contentResponse = []byte(`
+{
+    "success": true,
+    "data": {
+        "gear": {
+            "flat": {
+                "weapon_warrior_1": {
+                    "key": "weapon_warrior_1",
+                    "text": "Training Sword",
+                    "notes": "Practice weapon. Increases Strength by 3.",
+                    "type": "weapon",
+                    "klass": "warrior",
+                    "value": 20,
+                    "str": 3,
+                    "int": 0,
+                    "per": 0,
+                    "con": 0
+                }
+            }
+        },
+        "eggs": {
+            "Wolf": {
+                "key": "Wolf",
+                "text": "Wolf",
+                "adjective": "loyal",
+                "notes": "Find a hatching potion to pour on this egg.",
+                "value": 3
+            }
+        },
+        "hatchingPotions": {
+            "Base": {
+                "key": "Base",
+                "text": "Base",
+                "notes": "Pour this on an egg.",
+                "value": 2
+            }
+        },
+        "food": {
+            "Meat": {
+                "key": "Meat",
+                "text": "Meat",
+                "target": "Base",
+                "value": 1
+            }
+        },
+        "quests": {
+            "dustbunnies": {
+                "key": "dustbunnies",
+                "text": "The Feral Dust Bunnies",
+                "category": "unlockable",
+                "value": 1,
+                "boss": {
+                    "name": "Feral Dust Bunnies",
+                    "hp": 100,
+                    "str": 0.5
+                },
+                "drop": {
+                    "gp": 8,
+                    "exp": 42
+                }
+            }
+        },
+        "spells": {
+            "wizard": {
+                "fireball": {
+                    "key": "fireball",
+                    "text": "Burst of Flames",
+                    "mana": 10,
+                    "lvl": 11,
+                    "target": "task"
+                }
+            }
+        }
+    },
+    "appVersion": "5.1.0"
+}`)
@@ -0,0 +1,123 @@
+package habitica
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// ContentCache stores the responses of ContentService.Get keyed by language.
+// Get returns nil without an error when nothing is cached.
+type ContentCache interface {
+	Get(language string) (*CachedContent, error)
+	Set(language string, content *CachedContent) error
+}
+
+// CachedContent is a raw content response along with what is needed to
+// revalidate it.
+type CachedContent struct {
+	AppVersion string          `json:"appVersion"`
+	ETag       string          `json:"etag"`
+	Body       json.RawMessage `json:"body"`
+}
+
+// WithContentCache replaces the in-memory cache used by ContentService.
+func WithContentCache(cache ContentCache) ClientOpt {
+	return func(h *HabiticaClient) {
+		h.contentCache = cache
+	}
+}
+
+type memoryContentCache struct {
+	mu      sync.Mutex
+	entries map[string]*CachedContent
+}
+
+// NewMemoryContentCache returns a ContentCache that lives as long as the
+// process. It is the default for every client.
+func NewMemoryContentCache() ContentCache {
+	return &memoryContentCache{
+		entries: map[string]*CachedContent{},
+	}
+}
+
+func (c *memoryContentCache) Get(language string) (*CachedContent, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.entries[language], nil
+}
+
+func (c *memoryContentCache) Set(language string, content *CachedContent) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[language] = content
+	return nil
+}
+
+type fileContentCache struct {
+	dir string
+}
+
+// NewFileContentCache returns a ContentCache that keeps one file per
+// language in dir, so content survives between runs. The directory is
+// created on the first write.
+func NewFileContentCache(dir string) ContentCache {
+	return &fileContentCache{
+		dir: dir,
+	}
+}
+
+func (c *fileContentCache) path(language string) string {
+	if language == "" {
+		language = "default"
+	}
+	return filepath.Join(c.dir, fmt.Sprintf("content-%s.json", filepath.Base(language)))
+}
+
+func (c *fileContentCache) Get(language string) (*CachedContent, error) {
+	data, err := os.ReadFile(c.path(language))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	content := new(CachedContent)
+	err = json.Unmarshal(data, content)
+	if err != nil {
+		// A corrupt entry is treated as missing and overwritten by the next
+		// fetch.
+		return nil, nil
+	}
+	return content, nil
+}
+
+func (c *fileContentCache) Set(language string, content *CachedContent) error {
+	data, err := json.Marshal(content)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(c.dir, 0o755)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so a reader never sees a partial entry.
+	tmp, err := os.CreateTemp(c.dir, "content-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(data)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), c.path(language))
+}
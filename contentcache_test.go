@@ -0,0 +1,88 @@
+package habitica_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/wfernandes/go-habitica"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestMemoryContentCache(t *testing.T) {
+	RegisterTestingT(t)
+	cache := habitica.NewMemoryContentCache()
+
+	entry, err := cache.Get("en")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(entry).To(BeNil())
+
+	err = cache.Set("en", &habitica.CachedContent{AppVersion: "5.1.0", ETag: `W/"abc"`})
+	Expect(err).ToNot(HaveOccurred())
+	entry, err = cache.Get("en")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(entry.ETag).To(Equal(`W/"abc"`))
+}
+
+func TestFileContentCache(t *testing.T) {
+	RegisterTestingT(t)
+	dir := filepath.Join(t.TempDir(), "habitica")
+	cache := habitica.NewFileContentCache(dir)
+
+	entry, err := cache.Get("en")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(entry).To(BeNil())
+
+	err = cache.Set("en", &habitica.CachedContent{
+		AppVersion: "5.1.0",
+		ETag:       `W/"abc"`,
+		Body:       json.RawMessage(`{"success":true}`),
+	})
+	Expect(err).ToNot(HaveOccurred())
+
+	// A new cache on the same directory sees the entry, as on the next run.
+	entry, err = habitica.NewFileContentCache(dir).Get("en")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(entry.AppVersion).To(Equal("5.1.0"))
+	Expect(entry.ETag).To(Equal(`W/"abc"`))
+	Expect(string(entry.Body)).To(Equal(`{"success":true}`))
+
+	entry, err = cache.Get("de")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(entry).To(BeNil())
+
+	err = os.WriteFile(filepath.Join(dir, "content-de.json"), []byte("not json"), 0o644)
+	Expect(err).ToNot(HaveOccurred())
+	entry, err = cache.Get("de")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(entry).To(BeNil())
+}
+
+func TestWithContentCache(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/content", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `W/"abc"`)
+		w.WriteHeader(http.StatusOK)
+		w.Write(contentResponse)
+	})
+
+	cache := habitica.NewFileContentCache(t.TempDir())
+	c, err := habitica.New("b0413351-405f-416f-8787-947ec1c85199", "api",
+		habitica.WithBaseURL(ts.URL),
+		habitica.WithContentCache(cache),
+	)
+	Expect(err).ToNot(HaveOccurred())
+	_, err = c.Content.Get(ctx, "en")
+	Expect(err).ToNot(HaveOccurred())
+
+	entry, err := cache.Get("en")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(entry.AppVersion).To(Equal("5.1.0"))
+	Expect(entry.ETag).To(Equal(`W/"abc"`))
+}
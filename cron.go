@@ -0,0 +1,39 @@
+package habitica
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type CronResponse = Envelope[struct{}]
+
+// RunCron rolls over the user's day if it is due. The resulting damage and
+// rewards are reported in the Notifications of the response.
+func (s *UserService) RunCron(ctx context.Context) (*CronResponse, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "cron", nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[struct{}](ctx, s.client, req)
+}
+
+// Location returns the user's time zone. TimezoneOffset follows the
+// javascript convention of minutes behind UTC, so UTC-5 is 300.
+func (p Preferences) Location() *time.Location {
+	return time.FixedZone("", -p.TimezoneOffset*60)
+}
+
+// NextCron returns the first day boundary strictly after t, which is
+// DayStart o'clock in the user's time zone.
+func (p Preferences) NextCron(t time.Time) time.Time {
+	local := t.In(p.Location())
+	y, m, d := local.Date()
+	next := time.Date(y, m, d, p.DayStart, 0, 0, 0, local.Location())
+	if !next.After(local) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
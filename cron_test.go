@@ -0,0 +1,81 @@
+package habitica_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/wfernandes/go-habitica"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestRunCron(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/cron", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"success": true,
+			"data": {},
+			"notifications": [{"type": "CRON", "data": {"hp": -2.5, "mp": 10}, "id": "some-notification-id"}]
+		}`))
+	})
+	resp, err := client.User.RunCron(ctx)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+	Expect(resp.Notifications).To(HaveLen(1))
+	Expect(resp.Notifications[0].Type).To(Equal("CRON"))
+}
+
+func TestUser_CronFields(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"success": true,
+			"data": {"needsCron": true, "lastCron": "2017-01-13T09:00:00.000Z"}
+		}`))
+	})
+	resp, err := client.User.Get(ctx, "needsCron", "lastCron")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.Data.NeedsCron).To(BeTrue())
+	Expect(resp.Data.LastCron).To(Equal(time.Date(2017, time.January, 13, 9, 0, 0, 0, time.UTC)))
+}
+
+func TestNextCron_LaterToday(t *testing.T) {
+	RegisterTestingT(t)
+	prefs := habitica.Preferences{DayStart: 4, TimezoneOffset: 300}
+
+	// 01:00 in UTC-5
+	now := time.Date(2017, time.January, 13, 6, 0, 0, 0, time.UTC)
+	next := prefs.NextCron(now)
+	Expect(next.Equal(time.Date(2017, time.January, 13, 9, 0, 0, 0, time.UTC))).To(BeTrue())
+}
+
+func TestNextCron_Tomorrow(t *testing.T) {
+	RegisterTestingT(t)
+	prefs := habitica.Preferences{DayStart: 4, TimezoneOffset: 300}
+
+	// exactly 04:00 in UTC-5
+	now := time.Date(2017, time.January, 13, 9, 0, 0, 0, time.UTC)
+	next := prefs.NextCron(now)
+	Expect(next.Equal(time.Date(2017, time.January, 14, 9, 0, 0, 0, time.UTC))).To(BeTrue())
+}
+
+func TestNextCron_EastOfUTC(t *testing.T) {
+	RegisterTestingT(t)
+	prefs := habitica.Preferences{DayStart: 0, TimezoneOffset: -120}
+
+	// 23:30 in UTC+2
+	now := time.Date(2017, time.January, 13, 21, 30, 0, 0, time.UTC)
+	next := prefs.NextCron(now)
+	Expect(next.Equal(time.Date(2017, time.January, 13, 22, 0, 0, 0, time.UTC))).To(BeTrue())
+}
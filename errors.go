@@ -0,0 +1,125 @@
+package habitica
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Sentinel errors that an *ErrorResponse matches with errors.Is based on the
+// HTTP status code returned by the API.
+var (
+	ErrBadRequest   = errors.New("habitica: bad request")
+	ErrUnauthorized = errors.New("habitica: not authorized")
+	ErrForbidden    = errors.New("habitica: forbidden")
+	ErrNotFound     = errors.New("habitica: not found")
+	ErrRateLimited  = errors.New("habitica: rate limited")
+	ErrServer       = errors.New("habitica: server error")
+)
+
+// Purchase errors that an *ErrorResponse matches with errors.Is based on the
+// English message of the 401 NotAuthorized error returned by the API. Habitica
+// translates messages into the user's language, so for other languages these
+// do not match and the error only matches ErrUnauthorized.
+var (
+	ErrNotEnoughGold        = errors.New("habitica: not enough gold")
+	ErrNotEnoughGems        = errors.New("habitica: not enough gems")
+	ErrNotEnoughHourglasses = errors.New("habitica: not enough mystic hourglasses")
+)
+
+// ErrorResponse is returned by HabiticaClient.Do for every non-2xx response.
+type ErrorResponse struct {
+	Response   *http.Response    `json:"-"`
+	StatusCode int               `json:"-"`
+	Code       string            `json:"error"`
+	Message    string            `json:"message"`
+	Errors     []ValidationError `json:"errors,omitempty"`
+
+	// Rate and RetryAfter are parsed from the response headers.
+	Rate       Rate          `json:"-"`
+	RetryAfter time.Duration `json:"-"`
+}
+
+// ValidationError describes a single invalid parameter reported by the API.
+type ValidationError struct {
+	Message string      `json:"message"`
+	Param   string      `json:"param"`
+	Value   interface{} `json:"value,omitempty"`
+}
+
+func (e *ErrorResponse) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "habitica: %d", e.StatusCode)
+	if e.Code != "" {
+		fmt.Fprintf(&b, " %s", e.Code)
+	}
+	if e.Message != "" {
+		fmt.Fprintf(&b, ": %s", e.Message)
+	}
+	for _, v := range e.Errors {
+		fmt.Fprintf(&b, "; %s: %s", v.Param, v.Message)
+	}
+	return b.String()
+}
+
+// Is reports whether the error matches one of the sentinel errors.
+func (e *ErrorResponse) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServer:
+		return e.StatusCode >= http.StatusInternalServerError
+	case ErrNotEnoughGold:
+		return e.notAuthorized("not enough gold")
+	case ErrNotEnoughGems:
+		return e.notAuthorized("not enough gems")
+	case ErrNotEnoughHourglasses:
+		return e.notAuthorized("enough mystic hourglasses")
+	}
+	return false
+}
+
+// notAuthorized reports whether the error is a NotAuthorized error whose
+// message contains substr, ignoring case.
+func (e *ErrorResponse) notAuthorized(substr string) bool {
+	return e.StatusCode == http.StatusUnauthorized &&
+		strings.Contains(strings.ToLower(e.Message), substr)
+}
+
+// IsRateLimited reports whether err was caused by the API rate limit.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+func checkResponse(resp *http.Response) error {
+	if c := resp.StatusCode; c >= 200 && c <= 299 {
+		return nil
+	}
+
+	errResp := &ErrorResponse{
+		Response:   resp,
+		StatusCode: resp.StatusCode,
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err == nil && len(data) > 0 {
+		// The body is not guaranteed to be JSON (e.g. from a proxy), in which
+		// case we still return the status based error.
+		json.Unmarshal(data, errResp)
+	}
+	if errResp.Message == "" {
+		errResp.Message = http.StatusText(resp.StatusCode)
+	}
+	return errResp
+}
@@ -0,0 +1,110 @@
+package habitica_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/wfernandes/go-habitica"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestError_NotFound(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tasks/some-task-id", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write(notFoundResponse)
+	})
+
+	resp, err := client.Tasks.Get(ctx, "some-task-id")
+	Expect(resp).To(BeNil())
+	Expect(err).To(HaveOccurred())
+	Expect(errors.Is(err, habitica.ErrNotFound)).To(BeTrue())
+	Expect(errors.Is(err, habitica.ErrUnauthorized)).To(BeFalse())
+
+	var errResp *habitica.ErrorResponse
+	Expect(errors.As(err, &errResp)).To(BeTrue())
+	Expect(errResp.StatusCode).To(Equal(http.StatusNotFound))
+	Expect(errResp.Code).To(Equal("NotFound"))
+	Expect(errResp.Message).To(Equal("Task not found."))
+}
+
+func TestError_RateLimited(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tags", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"success":false,"error":"TooManyRequests","message":"Too many requests."}`))
+	})
+
+	_, err := client.Tags.List(ctx)
+	Expect(err).To(HaveOccurred())
+	Expect(habitica.IsRateLimited(err)).To(BeTrue())
+}
+
+func TestError_ValidationErrors(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tasks/user", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write(validationErrorResponse)
+	})
+
+	_, err := client.Tasks.Create(ctx, &habitica.Task{})
+	Expect(errors.Is(err, habitica.ErrBadRequest)).To(BeTrue())
+
+	var errResp *habitica.ErrorResponse
+	Expect(errors.As(err, &errResp)).To(BeTrue())
+	Expect(errResp.Errors).To(HaveLen(1))
+	Expect(errResp.Errors[0].Param).To(Equal("text"))
+	Expect(errResp.Errors[0].Message).To(Equal("Task text cannot be empty."))
+	Expect(err.Error()).To(ContainSubstring("Task text cannot be empty."))
+}
+
+func TestError_NonJSONBody(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tasks/some-task-id", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte(`<html>Bad Gateway</html>`))
+	})
+
+	_, err := client.Tasks.Get(ctx, "some-task-id")
+	Expect(errors.Is(err, habitica.ErrServer)).To(BeTrue())
+
+	var errResp *habitica.ErrorResponse
+	Expect(errors.As(err, &errResp)).To(BeTrue())
+	Expect(errResp.Message).To(Equal(http.StatusText(http.StatusBadGateway)))
+}
+
+var notFoundResponse = []byte(`
+{
+    "success": false,
+    "error": "NotFound",
+    "message": "Task not found."
+}`)
+
+var validationErrorResponse = []byte(`
+{
+    "success": false,
+    "error": "BadRequest",
+    "message": "Task validation failed",
+    "errors": [
+        {
+            "message": "Task text cannot be empty.",
+            "path": "text",
+            "param": "text",
+            "value": ""
+        }
+    ]
+}`)
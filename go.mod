@@ -0,0 +1,14 @@
+module github.com/wfernandes/go-habitica
+
+go 1.24
+
+require (
+	github.com/onsi/gomega v1.30.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/google/go-cmp v0.6.0 // indirect
+	golang.org/x/net v0.17.0 // indirect
+	golang.org/x/text v0.13.0 // indirect
+)
@@ -0,0 +1,252 @@
+package habitica
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type GroupType string
+
+const (
+	GroupTypeParty GroupType = "party"
+	GroupTypeGuild GroupType = "guild"
+)
+
+// GroupListType selects which groups GroupService.List returns.
+type GroupListType string
+
+const (
+	Party         GroupListType = "party"
+	Guilds        GroupListType = "guilds"
+	PrivateGuilds GroupListType = "privateGuilds"
+	PublicGuilds  GroupListType = "publicGuilds"
+	Tavern        GroupListType = "tavern"
+)
+
+// PartyID can be passed as a group id to refer to the user's party.
+const PartyID = "party"
+
+type Group struct {
+	ID             string      `json:"id,omitempty"`
+	Name           string      `json:"name,omitempty"`
+	Type           GroupType   `json:"type,omitempty"`
+	Privacy        string      `json:"privacy,omitempty"`
+	Summary        string      `json:"summary,omitempty"`
+	Description    string      `json:"description,omitempty"`
+	Leader         GroupLeader `json:"leader,omitzero"`
+	MemberCount    int         `json:"memberCount,omitempty"`
+	ChallengeCount int         `json:"challengeCount,omitempty"`
+	Balance        float64     `json:"balance,omitempty"`
+	LeaderOnly     *LeaderOnly `json:"leaderOnly,omitempty"`
+	Quest          *GroupQuest `json:"quest,omitempty"`
+}
+
+// GroupLeader is the member leading a group. The API sends either just the
+// id or the leader's public profile depending on the endpoint.
+type GroupLeader struct {
+	ID   string
+	Name string
+}
+
+func (l *GroupLeader) UnmarshalJSON(data []byte) error {
+	var id string
+	if json.Unmarshal(data, &id) == nil {
+		*l = GroupLeader{ID: id}
+		return nil
+	}
+
+	var leader struct {
+		ID      string  `json:"_id"`
+		Profile Profile `json:"profile"`
+	}
+	err := json.Unmarshal(data, &leader)
+	if err != nil {
+		return err
+	}
+	*l = GroupLeader{ID: leader.ID, Name: leader.Profile.Name}
+	return nil
+}
+
+func (l GroupLeader) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.ID)
+}
+
+type LeaderOnly struct {
+	Challenges bool `json:"challenges"`
+	GetGems    bool `json:"getGems"`
+}
+
+// Invitation lists the users to invite to a group.
+type Invitation struct {
+	UUIDs  []string      `json:"uuids,omitempty"`
+	Emails []EmailInvite `json:"emails,omitempty"`
+}
+
+type EmailInvite struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+type GroupListOptions struct {
+	Paginate bool `url:"paginate,omitempty"`
+	Page     int  `url:"page,omitempty"`
+}
+
+// MemberListOptions pages through group members. Set LastID to the id of
+// the last member of the previous page to get the next one.
+type MemberListOptions struct {
+	LastID                 string `url:"lastId,omitempty"`
+	Limit                  int    `url:"limit,omitempty"`
+	IncludeAllPublicFields bool   `url:"includeAllPublicFields,omitempty"`
+}
+
+type leaveOptions struct {
+	Keep string `url:"keep"`
+}
+
+type GroupResponse = Envelope[*Group]
+
+type GroupsResponse = Envelope[[]Group]
+
+type MembersResponse = Envelope[[]User]
+
+type InviteResponse = Envelope[json.RawMessage]
+
+type GroupService struct {
+	client *HabiticaClient
+}
+
+func newGroupService(h *HabiticaClient) *GroupService {
+	return &GroupService{
+		client: h,
+	}
+}
+
+// Get returns a group by id. Use PartyID to get the user's party.
+func (s *GroupService) Get(ctx context.Context, id string) (*GroupResponse, error) {
+	req, err := s.client.NewRequest(http.MethodGet, fmt.Sprintf("groups/%s", id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*Group](ctx, s.client, req)
+}
+
+// List returns the groups of the given type, which the API requires.
+func (s *GroupService) List(ctx context.Context, listType GroupListType, opts *GroupListOptions) (*GroupsResponse, error) {
+	urlPath, err := addOptions("groups?type="+url.QueryEscape(string(listType)), opts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode options: %s", err)
+	}
+	req, err := s.client.NewRequest(http.MethodGet, urlPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[[]Group](ctx, s.client, req)
+}
+
+func (s *GroupService) Create(ctx context.Context, group *Group) (*GroupResponse, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "groups", group)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*Group](ctx, s.client, req)
+}
+
+func (s *GroupService) Update(ctx context.Context, id string, group *Group) (*GroupResponse, error) {
+	req, err := s.client.NewRequest(http.MethodPut, fmt.Sprintf("groups/%s", id), group)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*Group](ctx, s.client, req)
+}
+
+func (s *GroupService) Join(ctx context.Context, id string) (*GroupResponse, error) {
+	req, err := s.client.NewRequest(http.MethodPost, fmt.Sprintf("groups/%s/join", id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*Group](ctx, s.client, req)
+}
+
+// Leave leaves the group. If keepTasks is false the group's tasks are
+// removed from the user's task list.
+func (s *GroupService) Leave(ctx context.Context, id string, keepTasks bool) (*GroupResponse, error) {
+	opts := &leaveOptions{Keep: "remove-all"}
+	if keepTasks {
+		opts.Keep = "keep-all"
+	}
+	urlPath, err := addOptions(fmt.Sprintf("groups/%s/leave", id), opts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode options: %s", err)
+	}
+	req, err := s.client.NewRequest(http.MethodPost, urlPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*Group](ctx, s.client, req)
+}
+
+func (s *GroupService) RejectInvite(ctx context.Context, id string) (*GroupResponse, error) {
+	req, err := s.client.NewRequest(http.MethodPost, fmt.Sprintf("groups/%s/reject-invite", id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*Group](ctx, s.client, req)
+}
+
+// Invite invites users to the group by user id or email address.
+func (s *GroupService) Invite(ctx context.Context, id string, invitation *Invitation) (*InviteResponse, error) {
+	req, err := s.client.NewRequest(http.MethodPost, fmt.Sprintf("groups/%s/invite", id), invitation)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[json.RawMessage](ctx, s.client, req)
+}
+
+func (s *GroupService) RemoveMember(ctx context.Context, id, memberID string) (*GroupResponse, error) {
+	req, err := s.client.NewRequest(http.MethodPost, fmt.Sprintf("groups/%s/removeMember/%s", id, memberID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*Group](ctx, s.client, req)
+}
+
+// Members returns a page of the group's members.
+func (s *GroupService) Members(ctx context.Context, id string, opts *MemberListOptions) (*MembersResponse, error) {
+	urlPath, err := addOptions(fmt.Sprintf("groups/%s/members", id), opts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode options: %s", err)
+	}
+	req, err := s.client.NewRequest(http.MethodGet, urlPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[[]User](ctx, s.client, req)
+}
+
+// ListTasks returns the tasks shared with the group.
+func (s *GroupService) ListTasks(ctx context.Context, id string, opts *TaskListOptions) (*TasksResponse, error) {
+	urlPath, err := addOptions(fmt.Sprintf("tasks/group/%s", id), opts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode options: %s", err)
+	}
+	req, err := s.client.NewRequest(http.MethodGet, urlPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[[]Task](ctx, s.client, req)
+}
@@ -0,0 +1,229 @@
+package habitica_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/wfernandes/go-habitica"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestGet_Party(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/groups/party", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(groupResponse)
+	})
+	resp, err := client.Groups.Get(ctx, habitica.PartyID)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodGet))
+
+	group := resp.Data
+	Expect(group.ID).To(Equal("e7b3dd5b-0b8a-4e2c-8c2a-5a9d3b8c1f2e"))
+	Expect(group.Name).To(Equal("Team Party"))
+	Expect(group.Type).To(Equal(habitica.GroupTypeParty))
+	Expect(group.MemberCount).To(Equal(3))
+	Expect(group.Leader.ID).To(Equal("b0413351-405f-416f-8787-947ec1c85199"))
+	Expect(group.Leader.Name).To(Equal("API Tester"))
+}
+
+func TestGroup_LeaderAsID(t *testing.T) {
+	RegisterTestingT(t)
+	group := habitica.Group{}
+	err := json.Unmarshal([]byte(`{"name": "Guild", "leader": "some-user-id"}`), &group)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(group.Leader.ID).To(Equal("some-user-id"))
+
+	data, err := json.Marshal(group)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(string(data)).To(ContainSubstring(`"leader":"some-user-id"`))
+}
+
+func TestList_Groups(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/groups", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": [{"id": "g1", "name": "Guild One", "type": "guild"}]}`))
+	})
+	resp, err := client.Groups.List(ctx, habitica.Guilds, &habitica.GroupListOptions{Paginate: true, Page: 2})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.URL.Query().Get("type")).To(Equal("guilds"))
+	Expect(request.URL.Query().Get("paginate")).To(Equal("true"))
+	Expect(request.URL.Query().Get("page")).To(Equal("2"))
+
+	_, err = client.Groups.List(ctx, habitica.Party, nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.URL.RawQuery).To(Equal("type=party"))
+	Expect(resp.Data).To(HaveLen(1))
+	Expect(resp.Data[0].Type).To(Equal(habitica.GroupTypeGuild))
+}
+
+func TestCreate_Group(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	received := habitica.Group{}
+	mux.HandleFunc("/groups", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&received)
+		request = r
+		w.WriteHeader(http.StatusCreated)
+		w.Write(groupResponse)
+	})
+	_, err := client.Groups.Create(ctx, &habitica.Group{Name: "Team Party", Type: habitica.GroupTypeParty, Privacy: "private"})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+	Expect(received.Name).To(Equal("Team Party"))
+	Expect(received.Type).To(Equal(habitica.GroupTypeParty))
+}
+
+func TestUpdate_Group(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	var body map[string]interface{}
+	mux.HandleFunc("/groups/some-group-id", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(groupResponse)
+	})
+	_, err := client.Groups.Update(ctx, "some-group-id", &habitica.Group{Description: "New description"})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPut))
+	Expect(body).To(Equal(map[string]interface{}{"description": "New description"}))
+}
+
+func TestGroup_Membership(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	paths := []string{}
+	mux.HandleFunc("/groups/some-group-id/", func(w http.ResponseWriter, r *http.Request) {
+		Expect(r.Method).To(Equal(http.MethodPost))
+		paths = append(paths, r.URL.RequestURI())
+		w.WriteHeader(http.StatusOK)
+		w.Write(groupResponse)
+	})
+	_, err := client.Groups.Join(ctx, "some-group-id")
+	Expect(err).ToNot(HaveOccurred())
+	_, err = client.Groups.RejectInvite(ctx, "some-group-id")
+	Expect(err).ToNot(HaveOccurred())
+	_, err = client.Groups.RemoveMember(ctx, "some-group-id", "some-member-id")
+	Expect(err).ToNot(HaveOccurred())
+	_, err = client.Groups.Leave(ctx, "some-group-id", true)
+	Expect(err).ToNot(HaveOccurred())
+	_, err = client.Groups.Leave(ctx, "some-group-id", false)
+	Expect(err).ToNot(HaveOccurred())
+
+	Expect(paths).To(Equal([]string{
+		"/groups/some-group-id/join",
+		"/groups/some-group-id/reject-invite",
+		"/groups/some-group-id/removeMember/some-member-id",
+		"/groups/some-group-id/leave?keep=keep-all",
+		"/groups/some-group-id/leave?keep=remove-all",
+	}))
+}
+
+func TestInvite_Group(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	received := habitica.Invitation{}
+	mux.HandleFunc("/groups/some-group-id/invite", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&received)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": [{"id": "some-user-id"}, "friend@example.com"]}`))
+	})
+	invitation := &habitica.Invitation{
+		UUIDs:  []string{"some-user-id"},
+		Emails: []habitica.EmailInvite{{Email: "friend@example.com", Name: "Friend"}},
+	}
+	resp, err := client.Groups.Invite(ctx, "some-group-id", invitation)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(received).To(Equal(*invitation))
+	Expect(resp.Data).ToNot(BeEmpty())
+}
+
+func TestMembers_Paginated(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/groups/party/members", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": [
+			{"_id": "m2", "id": "m2", "profile": {"name": "Second"}},
+			{"_id": "m3", "id": "m3", "profile": {"name": "Third"}}
+		]}`))
+	})
+	resp, err := client.Groups.Members(ctx, habitica.PartyID, &habitica.MemberListOptions{LastID: "m1", Limit: 2})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.URL.Query().Get("lastId")).To(Equal("m1"))
+	Expect(request.URL.Query().Get("limit")).To(Equal("2"))
+	Expect(resp.Data).To(HaveLen(2))
+	Expect(resp.Data[1].Profile.Name).To(Equal("Third"))
+}
+
+func TestListTasks_Group(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/tasks/group/some-group-id", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(userTasksResponse)
+	})
+	resp, err := client.Groups.ListTasks(ctx, "some-group-id", nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodGet))
+	Expect(resp.Data).To(HaveLen(1))
+}
+
+var groupResponse = []byte(`
+{
+    "success": true,
+    "data": {
+        "_id": "e7b3dd5b-0b8a-4e2c-8c2a-5a9d3b8c1f2e",
+        "id": "e7b3dd5b-0b8a-4e2c-8c2a-5a9d3b8c1f2e",
+        "name": "Team Party",
+        "type": "party",
+        "privacy": "private",
+        "memberCount": 3,
+        "challengeCount": 0,
+        "balance": 0,
+        "leaderOnly": {
+            "challenges": false,
+            "getGems": false
+        },
+        "leader": {
+            "_id": "b0413351-405f-416f-8787-947ec1c85199",
+            "id": "b0413351-405f-416f-8787-947ec1c85199",
+            "profile": {
+                "name": "API Tester"
+            }
+        }
+    },
+    "notifications": []
+}`)
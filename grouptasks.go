@@ -0,0 +1,73 @@
+package habitica
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// CreateGroupTask creates a task shared with the group. Set
+// Task.RequiresApproval for tasks that need a manager's approval.
+func (t *TaskService) CreateGroupTask(ctx context.Context, groupID string, task *Task) (*TaskResponse, error) {
+	req, err := t.client.NewRequest(http.MethodPost, fmt.Sprintf("tasks/group/%s", groupID), task)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*Task](ctx, t.client, req)
+}
+
+func (t *TaskService) Assign(ctx context.Context, taskID, memberID string) (*TaskResponse, error) {
+	req, err := t.client.NewRequest(http.MethodPost, fmt.Sprintf("tasks/%s/assign/%s", taskID, memberID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*Task](ctx, t.client, req)
+}
+
+func (t *TaskService) Unassign(ctx context.Context, taskID, memberID string) (*TaskResponse, error) {
+	req, err := t.client.NewRequest(http.MethodPost, fmt.Sprintf("tasks/%s/unassign/%s", taskID, memberID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*Task](ctx, t.client, req)
+}
+
+// Claim assigns a group task to the authenticated user.
+func (t *TaskService) Claim(ctx context.Context, taskID string) (*TaskResponse, error) {
+	return t.Assign(ctx, taskID, t.client.userID)
+}
+
+// Approve approves the completion of a group task by the given member.
+func (t *TaskService) Approve(ctx context.Context, taskID, memberID string) (*TaskResponse, error) {
+	req, err := t.client.NewRequest(http.MethodPost, fmt.Sprintf("tasks/%s/approve/%s", taskID, memberID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*Task](ctx, t.client, req)
+}
+
+// ListApprovals returns the group tasks waiting for a manager's approval.
+func (t *TaskService) ListApprovals(ctx context.Context, groupID string) (*TasksResponse, error) {
+	req, err := t.client.NewRequest(http.MethodGet, fmt.Sprintf("approvals/group/%s", groupID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[[]Task](ctx, t.client, req)
+}
+
+// SetRequiresApproval changes whether completing a group task needs a
+// manager's approval.
+func (t *TaskService) SetRequiresApproval(ctx context.Context, taskID string, required bool) (*TaskResponse, error) {
+	body := map[string]bool{"requiresApproval": required}
+	req, err := t.client.NewRequest(http.MethodPut, fmt.Sprintf("tasks/%s", taskID), body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*Task](ctx, t.client, req)
+}
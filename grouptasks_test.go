@@ -0,0 +1,160 @@
+package habitica_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/wfernandes/go-habitica"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCreate_GroupTask(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	var received map[string]interface{}
+	mux.HandleFunc("/tasks/group/some-group-id", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&received)
+		request = r
+		w.WriteHeader(http.StatusCreated)
+		w.Write(groupTaskResponse)
+	})
+	task := &habitica.Task{
+		Text:             "Take out the trash",
+		Type:             habitica.TaskTypeTodo,
+		RequiresApproval: habitica.Bool(true),
+	}
+	resp, err := client.Tasks.CreateGroupTask(ctx, "some-group-id", task)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+	Expect(received).To(HaveKeyWithValue("text", "Take out the trash"))
+	Expect(received).To(HaveKeyWithValue("requiresApproval", true))
+	Expect(resp.Data.Group.ID).To(Equal("some-group-id"))
+	Expect(resp.Data.Group.Approval.Required).To(BeTrue())
+}
+
+func TestAssign_GroupTask(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/tasks/some-task-id/assign/some-member-id", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(groupTaskResponse)
+	})
+	resp, err := client.Tasks.Assign(ctx, "some-task-id", "some-member-id")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+	Expect(resp.Data.Group.AssignedUsers).To(ConsistOf("some-member-id"))
+}
+
+func TestUnassign_GroupTask(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/tasks/some-task-id/unassign/some-member-id", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(groupTaskResponse)
+	})
+	_, err := client.Tasks.Unassign(ctx, "some-task-id", "some-member-id")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+}
+
+func TestClaim_GroupTask(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/tasks/some-task-id/assign/b0413351-405f-416f-8787-947ec1c85199", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(groupTaskResponse)
+	})
+	_, err := client.Tasks.Claim(ctx, "some-task-id")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+}
+
+func TestApprove_GroupTask(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/tasks/some-task-id/approve/some-member-id", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(groupTaskResponse)
+	})
+	_, err := client.Tasks.Approve(ctx, "some-task-id", "some-member-id")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+}
+
+func TestListApprovals_Group(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/approvals/group/some-group-id", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": [{"id": "some-task-id", "text": "Take out the trash", "group": {"approval": {"required": true, "requested": true}}}]}`))
+	})
+	resp, err := client.Tasks.ListApprovals(ctx, "some-group-id")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodGet))
+	Expect(resp.Data).To(HaveLen(1))
+	Expect(resp.Data[0].Group.Approval.Requested).To(BeTrue())
+}
+
+func TestSetRequiresApproval(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	var received map[string]interface{}
+	mux.HandleFunc("/tasks/some-task-id", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&received)
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(groupTaskResponse)
+	})
+	_, err := client.Tasks.SetRequiresApproval(ctx, "some-task-id", false)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPut))
+	Expect(received).To(Equal(map[string]interface{}{"requiresApproval": false}))
+}
+
+var groupTaskResponse = []byte(`
+{
+    "success": true,
+    "data": {
+        "id": "some-task-id",
+        "text": "Take out the trash",
+        "type": "todo",
+        "group": {
+            "id": "some-group-id",
+            "assignedUsers": ["some-member-id"],
+            "approval": {
+                "required": true,
+                "approved": false,
+                "requested": false
+            }
+        }
+    },
+    "notifications": []
+}`)
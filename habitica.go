@@ -8,6 +8,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
 )
 
 const (
@@ -15,15 +19,44 @@ const (
 	UserAgent = "go-habitica/1" // 1 is the version
 )
 
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
 type HabiticaClient struct {
 	userID    string
-	apiToken  string
 	BaseURL   string
 	UserAgent string
 	Client    *http.Client
 
-	Tasks *TaskService
-	Tags  *TagService
+	xClientAuthor string
+	xClientApp    string
+
+	// apiToken changes when it is rotated with AuthService.
+	tokenMu  sync.Mutex
+	apiToken string
+
+	rateMu     sync.Mutex
+	rate       Rate
+	limitRate  bool
+	maxRetries int
+
+	versionMu  sync.Mutex
+	appVersion string
+
+	contentCache ContentCache
+
+	Tasks     *TaskService
+	Tags      *TagService
+	User      *UserService
+	Groups    *GroupService
+	Chat      *ChatService
+	Inbox     *InboxService
+	Quests    *QuestService
+	Skills    *SkillService
+	Inventory *InventoryService
+	Shops     *ShopService
+	Content   *ContentService
+	Webhooks  *WebhookService
+	Auth      *AuthService
 }
 
 type ClientOpt func(*HabiticaClient)
@@ -32,21 +65,49 @@ func New(userID, apiToken string, opts ...ClientOpt) (*HabiticaClient, error) {
 	if len(userID) == 0 || len(apiToken) == 0 {
 		return nil, errors.New("needs valid user id and api token")
 	}
+	return newClient(userID, apiToken, opts...)
+}
 
+// NewUnauthenticated returns a client without credentials, for logging in
+// or registering with AuthService to obtain them. Other requests made with
+// it fail as unauthorized.
+func NewUnauthenticated(opts ...ClientOpt) (*HabiticaClient, error) {
+	return newClient("", "", opts...)
+}
+
+func newClient(userID, apiToken string, opts ...ClientOpt) (*HabiticaClient, error) {
 	h := &HabiticaClient{
 		userID:    userID,
 		apiToken:  apiToken,
 		BaseURL:   baseURL,
 		UserAgent: UserAgent,
 		Client:    http.DefaultClient,
+
+		contentCache: NewMemoryContentCache(),
 	}
 
 	for _, o := range opts {
 		o(h)
 	}
 
+	err := h.validateXClient()
+	if err != nil {
+		return nil, err
+	}
+
 	h.Tasks = newTaskService(h)
 	h.Tags = newTagService(h)
+	h.User = newUserService(h)
+	h.Groups = newGroupService(h)
+	h.Chat = newChatService(h)
+	h.Inbox = newInboxService(h)
+	h.Quests = newQuestService(h)
+	h.Skills = newSkillService(h)
+	h.Inventory = newInventoryService(h)
+	h.Shops = newShopService(h)
+	h.Content = newContentService(h)
+	h.Webhooks = newWebhookService(h)
+	h.Auth = newAuthService(h)
 
 	return h, nil
 }
@@ -63,6 +124,72 @@ func WithHttpClient(c *http.Client) func(*HabiticaClient) {
 	}
 }
 
+func WithUserAgent(userAgent string) ClientOpt {
+	return func(h *HabiticaClient) {
+		h.UserAgent = userAgent
+	}
+}
+
+// WithXClient sets the x-client header Habitica requires from third party
+// tools. authorID is the Habitica user id of the tool's author.
+func WithXClient(authorID, appName string) ClientOpt {
+	return func(h *HabiticaClient) {
+		h.xClientAuthor = authorID
+		h.xClientApp = appName
+	}
+}
+
+func (h *HabiticaClient) validateXClient() error {
+	if h.xClientAuthor == "" && h.xClientApp == "" {
+		return nil
+	}
+	if !uuidPattern.MatchString(h.xClientAuthor) {
+		return fmt.Errorf("x-client author id must be a uuid: %q", h.xClientAuthor)
+	}
+	if h.xClientApp == "" || strings.ContainsAny(h.xClientApp, " \t\r\n") {
+		return fmt.Errorf("x-client app name must be non-empty without whitespace: %q", h.xClientApp)
+	}
+	return nil
+}
+
+func (h *HabiticaClient) token() string {
+	h.tokenMu.Lock()
+	defer h.tokenMu.Unlock()
+	return h.apiToken
+}
+
+func (h *HabiticaClient) setToken(apiToken string) {
+	h.tokenMu.Lock()
+	defer h.tokenMu.Unlock()
+	h.apiToken = apiToken
+}
+
+// AppVersion returns the Habitica version reported by the most recent
+// response, or an empty string if none has been received yet.
+func (h *HabiticaClient) AppVersion() string {
+	h.versionMu.Lock()
+	defer h.versionMu.Unlock()
+	return h.appVersion
+}
+
+func (h *HabiticaClient) setAppVersion(version string) {
+	if version == "" {
+		return
+	}
+	h.versionMu.Lock()
+	defer h.versionMu.Unlock()
+	h.appVersion = version
+}
+
+// XClient returns the value sent in the x-client header, or an empty string
+// if none was configured.
+func (h *HabiticaClient) XClient() string {
+	if h.xClientAuthor == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s-%s", h.xClientAuthor, h.xClientApp)
+}
+
 func (h *HabiticaClient) NewRequest(method, urlPath string, body interface{}) (*http.Request, error) {
 	url := fmt.Sprintf("%s/%s", h.BaseURL, urlPath)
 
@@ -79,14 +206,142 @@ func (h *HabiticaClient) NewRequest(method, urlPath string, body interface{}) (*
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("User-Agent", UserAgent)
+	req.Header.Set("User-Agent", h.UserAgent)
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("x-api-user", h.userID)
-	req.Header.Set("x-api-key", h.apiToken)
+	if h.userID != "" {
+		req.Header.Set("x-api-user", h.userID)
+		req.Header.Set("x-api-key", h.token())
+	}
+	if xc := h.XClient(); xc != "" {
+		req.Header.Set("x-client", xc)
+	}
 
 	return req, nil
 }
 
-func (h *HabiticaClient) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
-	return h.Client.Do(req)
+// Response wraps the http.Response returned by the API.
+type Response struct {
+	*http.Response
+
+	Rate Rate
+}
+
+// Envelope is the JSON object wrapping the data of every successful API
+// response.
+type Envelope[T any] struct {
+	Success       bool           `json:"success"`
+	Data          T              `json:"data,omitempty"`
+	Notifications []Notification `json:"notifications,omitempty"`
+	UserV         int            `json:"userV,omitempty"`
+	AppVersion    string         `json:"appVersion,omitempty"`
+	Message       string         `json:"message,omitempty"`
+
+	// Response is the raw response the envelope was decoded from.
+	Response *Response `json:"-"`
+}
+
+type Notification struct {
+	ID   string          `json:"id"`
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data,omitempty"`
+	Seen bool            `json:"seen"`
+}
+
+// Do sends the request with the given context and decodes the JSON response
+// body into v. If v implements io.Writer the raw body is copied into it
+// instead. Any non-2xx response is returned as an *ErrorResponse.
+//
+// Depending on the client options Do waits for the rate limit to reset
+// before sending and retries idempotent requests that were throttled.
+func (h *HabiticaClient) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	for attempt := 0; ; attempt++ {
+		if h.limitRate {
+			err := h.waitForRate(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("unable to rewind request body: %w", err)
+			}
+			req.Body = body
+		}
+
+		resp, err := h.send(ctx, req, v)
+		var errResp *ErrorResponse
+		if attempt >= h.maxRetries || !isIdempotent(req.Method) || retryDisabled(ctx) ||
+			!errors.As(err, &errResp) || !shouldRetry(errResp) {
+			return resp, err
+		}
+		if sleep(ctx, backoff(attempt, errResp)) != nil {
+			return resp, err
+		}
+	}
+}
+
+func (h *HabiticaClient) send(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	resp, err := h.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("unable to perform request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	response := &Response{
+		Response: resp,
+		Rate:     parseRate(resp),
+	}
+	h.setRate(response.Rate)
+
+	err = checkResponse(resp)
+	if err != nil {
+		errResp := err.(*ErrorResponse)
+		errResp.Rate = response.Rate
+		errResp.RetryAfter = parseRetryAfter(resp)
+		return response, err
+	}
+
+	switch v := v.(type) {
+	case nil:
+	case io.Writer:
+		_, err = io.Copy(v, resp.Body)
+	default:
+		err = json.NewDecoder(resp.Body).Decode(v)
+		if err == io.EOF {
+			err = nil // ignore empty bodies
+		}
+	}
+	if err != nil {
+		return response, fmt.Errorf("unable to decode response body: %w", err)
+	}
+	return response, nil
+}
+
+// do sends the request and decodes the response into an Envelope holding
+// data of type T.
+func do[T any](ctx context.Context, h *HabiticaClient, req *http.Request) (*Envelope[T], error) {
+	env := new(Envelope[T])
+	resp, err := h.Do(ctx, req, env)
+	if err != nil {
+		return nil, err
+	}
+	env.Response = resp
+	h.setAppVersion(env.AppVersion)
+	return env, nil
+}
+
+// unmarshalTimestamp decodes a time the API sends either as milliseconds
+// since the epoch or as an ISO 8601 string.
+func unmarshalTimestamp(data []byte, t *time.Time) error {
+	if len(data) == 0 || string(data) == "null" {
+		*t = time.Time{}
+		return nil
+	}
+	var ms int64
+	if json.Unmarshal(data, &ms) == nil {
+		*t = time.UnixMilli(ms)
+		return nil
+	}
+	return json.Unmarshal(data, t)
 }
@@ -1,7 +1,10 @@
 package habitica_test
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -114,3 +117,132 @@ func TestNewRequest_ErrorForBadMethod(t *testing.T) {
 	_, err = c.NewRequest(" GOT", "", nil)
 	Expect(err).To(HaveOccurred())
 }
+
+func TestDo_DecodesIntoValue(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tasks/some-task-id", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write(taskResponse)
+	})
+	req, err := client.NewRequest(http.MethodGet, "tasks/some-task-id", nil)
+	Expect(err).ToNot(HaveOccurred())
+
+	env := &habitica.Envelope[*habitica.Task]{}
+	resp, err := client.Do(ctx, req, env)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(env.Success).To(BeTrue())
+	Expect(env.Data.Text).To(Equal("API Trial"))
+}
+
+func TestDo_EnvelopeMetadata(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tags/some-tag-id", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"success": true,
+			"data": {"id": "some-tag-id", "name": "Work"},
+			"notifications": [{"id": "some-notification-id", "type": "CRON", "data": {"hp": 1}, "seen": false}],
+			"userV": 42,
+			"appVersion": "4.70.1"
+		}`))
+	})
+	resp, err := client.Tags.Get(ctx, "some-tag-id")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.UserV).To(Equal(42))
+	Expect(resp.AppVersion).To(Equal("4.70.1"))
+	Expect(resp.Notifications).To(HaveLen(1))
+	Expect(resp.Notifications[0].Type).To(Equal("CRON"))
+	Expect(resp.Response).ToNot(BeNil())
+	Expect(resp.Response.StatusCode).To(Equal(http.StatusOK))
+}
+
+func TestDo_CopiesIntoWriter(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/content", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`not json`))
+	})
+	req, err := client.NewRequest(http.MethodGet, "content", nil)
+	Expect(err).ToNot(HaveOccurred())
+
+	buf := &bytes.Buffer{}
+	_, err = client.Do(ctx, req, buf)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(buf.String()).To(Equal("not json"))
+}
+
+func TestDo_HonorsContext(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tasks/user", func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	_, err := client.Tasks.List(timeoutCtx, nil)
+	Expect(err).To(HaveOccurred())
+	Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+}
+
+func TestConfigure_UserAgent(t *testing.T) {
+	RegisterTestingT(t)
+	c, err := habitica.New("user", "api", habitica.WithUserAgent("my-tool/2"))
+	Expect(err).ToNot(HaveOccurred())
+	request, err := c.NewRequest(http.MethodGet, "tasks/user", nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.UserAgent()).To(Equal("my-tool/2"))
+
+	c.UserAgent = "my-tool/3"
+	request, err = c.NewRequest(http.MethodGet, "tasks/user", nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.UserAgent()).To(Equal("my-tool/3"))
+}
+
+func TestConfigure_XClient(t *testing.T) {
+	RegisterTestingT(t)
+	c, err := habitica.New(
+		"user",
+		"api",
+		habitica.WithXClient("b0413351-405f-416f-8787-947ec1c85199", "iot-buttons"),
+	)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(c.XClient()).To(Equal("b0413351-405f-416f-8787-947ec1c85199-iot-buttons"))
+
+	request, err := c.NewRequest(http.MethodGet, "tasks/user", nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Header.Get("x-client")).To(Equal("b0413351-405f-416f-8787-947ec1c85199-iot-buttons"))
+}
+
+func TestConfigure_NoXClientByDefault(t *testing.T) {
+	RegisterTestingT(t)
+	c, err := habitica.New("user", "api")
+	Expect(err).ToNot(HaveOccurred())
+	request, err := c.NewRequest(http.MethodGet, "tasks/user", nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Header.Get("x-client")).To(BeEmpty())
+}
+
+func TestValidation_XClient(t *testing.T) {
+	RegisterTestingT(t)
+	_, err := habitica.New("user", "api", habitica.WithXClient("not-a-uuid", "iot-buttons"))
+	Expect(err).To(HaveOccurred())
+
+	_, err = habitica.New("user", "api", habitica.WithXClient("b0413351-405f-416f-8787-947ec1c85199", ""))
+	Expect(err).To(HaveOccurred())
+
+	_, err = habitica.New("user", "api", habitica.WithXClient("b0413351-405f-416f-8787-947ec1c85199", "iot buttons"))
+	Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,190 @@
+// Package habiticatest provides an in-memory fake of the Habitica API for
+// testing code built on the habitica package.
+//
+//	fake := habiticatest.NewServer()
+//	defer fake.Close()
+//	client, err := fake.Client()
+//
+// The fake covers tasks, tags, checklists, scoring and the user's stats.
+package habiticatest
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/wfernandes/go-habitica"
+)
+
+// AppVersion is the app version the fake reports in every response.
+const AppVersion = "5.0.0-habiticatest"
+
+// Server is a fake Habitica API backed by memory. It is safe for concurrent
+// use by the client under test and the test inspecting its state.
+type Server struct {
+	// URL is the base URL of the fake, for use with habitica.WithBaseURL.
+	URL string
+
+	// UserID and APIToken are the only credentials the fake accepts.
+	UserID   string
+	APIToken string
+
+	srv *httptest.Server
+
+	mu    sync.Mutex
+	user  habitica.User
+	tasks []*habitica.Task
+	tags  []habitica.Tag
+}
+
+// NewServer starts a fake with a new level 1 user and no tasks or tags.
+// Call Close when done.
+func NewServer() *Server {
+	s := &Server{
+		UserID:   newID(),
+		APIToken: newID(),
+	}
+	s.user = habitica.User{
+		ID: s.UserID,
+		Auth: habitica.Auth{
+			Local: habitica.LocalAuth{Username: "habiticatest"},
+		},
+		Stats: habitica.Stats{
+			HP:          50,
+			MP:          10,
+			Lvl:         1,
+			Class:       habitica.ClassWarrior,
+			MaxHealth:   50,
+			MaxMP:       10,
+			ToNextLevel: toNextLevel(1),
+		},
+		Profile: habitica.Profile{Name: "Habitica Test"},
+	}
+
+	mux := http.NewServeMux()
+	s.routes(mux)
+	s.srv = httptest.NewServer(s.authenticate(mux))
+	s.URL = s.srv.URL
+	return s
+}
+
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client returns a client for the fake's user pointed at the fake.
+func (s *Server) Client(opts ...habitica.ClientOpt) (*habitica.HabiticaClient, error) {
+	opts = append([]habitica.ClientOpt{habitica.WithBaseURL(s.URL)}, opts...)
+	return habitica.New(s.UserID, s.APIToken, opts...)
+}
+
+func (s *Server) routes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /user", s.getUser)
+
+	mux.HandleFunc("GET /tasks/user", s.listTasks)
+	mux.HandleFunc("POST /tasks/user", s.createTask)
+	mux.HandleFunc("GET /tasks/{id}", s.getTask)
+	mux.HandleFunc("PUT /tasks/{id}", s.updateTask)
+	mux.HandleFunc("DELETE /tasks/{id}", s.deleteTask)
+	mux.HandleFunc("POST /tasks/{id}/score/{direction}", s.scoreTask)
+	mux.HandleFunc("POST /tasks/{id}/move/to/{position}", s.moveTask)
+	mux.HandleFunc("POST /tasks/{id}/tags/{tagID}", s.addTaskTag)
+	mux.HandleFunc("DELETE /tasks/{id}/tags/{tagID}", s.deleteTaskTag)
+	mux.HandleFunc("POST /tasks/{id}/checklist", s.addChecklistItem)
+	mux.HandleFunc("PUT /tasks/{id}/checklist/{itemID}", s.updateChecklistItem)
+	mux.HandleFunc("DELETE /tasks/{id}/checklist/{itemID}", s.deleteChecklistItem)
+	mux.HandleFunc("POST /tasks/{id}/checklist/{itemID}/score", s.scoreChecklistItem)
+	// The API matches routes case insensitively.
+	mux.HandleFunc("POST /tasks/clearCompletedTodos", s.clearCompletedTodos)
+	mux.HandleFunc("POST /tasks/clearcompletedtodos", s.clearCompletedTodos)
+
+	mux.HandleFunc("GET /tags", s.listTags)
+	mux.HandleFunc("POST /tags", s.createTag)
+	mux.HandleFunc("GET /tags/{id}", s.getTag)
+	mux.HandleFunc("PUT /tags/{id}", s.updateTag)
+	mux.HandleFunc("DELETE /tags/{id}", s.deleteTag)
+	mux.HandleFunc("POST /reorder-tags", s.reorderTags)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "NotFound", "Not found.")
+	})
+}
+
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("x-api-user") != s.UserID || r.Header.Get("x-api-key") != s.APIToken {
+			writeError(w, http.StatusUnauthorized, "NotAuthorized", "There is no account that uses those credentials.")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	writeData(w, http.StatusOK, s.user)
+}
+
+// User returns the fake's user.
+func (s *Server) User() habitica.User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.user
+}
+
+// SetStats replaces the user's stats, e.g. to give them gold before buying
+// a reward.
+func (s *Server) SetStats(stats habitica.Stats) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.user.Stats = stats
+}
+
+type errorBody struct {
+	Success bool   `json:"success"`
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, errorBody{Error: code, Message: message})
+}
+
+func writeData(w http.ResponseWriter, status int, data interface{}) {
+	writeJSON(w, status, habitica.Envelope[interface{}]{
+		Success:       true,
+		Data:          data,
+		Notifications: []habitica.Notification{},
+		AppVersion:    AppVersion,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// decode decodes the request body into v, writing a 400 response if it is
+// not valid JSON.
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", fmt.Sprintf("Invalid request body: %s", err))
+		return false
+	}
+	return true
+}
+
+// newID returns a random version 4 UUID, the format the API uses for ids.
+func newID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
@@ -0,0 +1,60 @@
+package habiticatest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/wfernandes/go-habitica"
+	"github.com/wfernandes/go-habitica/habiticatest"
+
+	. "github.com/onsi/gomega"
+)
+
+var ctx = context.Background()
+
+func newFake(t *testing.T) (*habiticatest.Server, *habitica.HabiticaClient) {
+	fake := habiticatest.NewServer()
+	t.Cleanup(fake.Close)
+	client, err := fake.Client()
+	Expect(err).ToNot(HaveOccurred())
+	return fake, client
+}
+
+func TestServer_User(t *testing.T) {
+	RegisterTestingT(t)
+	fake, client := newFake(t)
+
+	resp, err := client.User.Get(ctx)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.Data.ID).To(Equal(fake.UserID))
+	Expect(resp.Data.Stats.Lvl).To(Equal(1))
+	Expect(resp.Data.Stats.HP).To(Equal(50.0))
+	Expect(resp.Data.Stats.ToNextLevel).To(Equal(150))
+	Expect(resp.AppVersion).To(Equal(habiticatest.AppVersion))
+
+	stats := fake.User().Stats
+	stats.GP = 100
+	fake.SetStats(stats)
+	resp, err = client.User.Get(ctx)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.Data.Stats.GP).To(Equal(100.0))
+}
+
+func TestServer_RejectsOtherCredentials(t *testing.T) {
+	RegisterTestingT(t)
+	fake, _ := newFake(t)
+
+	client, err := habitica.New(fake.UserID, "wrong-token", habitica.WithBaseURL(fake.URL))
+	Expect(err).ToNot(HaveOccurred())
+	_, err = client.User.Get(ctx)
+	Expect(errors.Is(err, habitica.ErrUnauthorized)).To(BeTrue())
+}
+
+func TestServer_UnknownRoute(t *testing.T) {
+	RegisterTestingT(t)
+	_, client := newFake(t)
+
+	_, err := client.Groups.Get(ctx, habitica.PartyID)
+	Expect(errors.Is(err, habitica.ErrNotFound)).To(BeTrue())
+}
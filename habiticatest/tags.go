@@ -0,0 +1,132 @@
+package habiticatest
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/wfernandes/go-habitica"
+)
+
+// AddTag stores a tag as if it was created through the API and returns it
+// with its id filled in.
+func (s *Server) AddTag(name string) habitica.Tag {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addTag(name)
+}
+
+// Tags returns the user's tags in order.
+func (s *Server) Tags() []habitica.Tag {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.tags)
+}
+
+func (s *Server) addTag(name string) habitica.Tag {
+	tag := habitica.Tag{ID: newID(), Name: name}
+	s.tags = append(s.tags, tag)
+	return tag
+}
+
+func (s *Server) findTag(id string) int {
+	return slices.IndexFunc(s.tags, func(t habitica.Tag) bool { return t.ID == id })
+}
+
+// tag looks up the tag in the path, writing a 404 response if it does not
+// exist. The caller must hold s.mu.
+func (s *Server) tag(w http.ResponseWriter, r *http.Request) int {
+	i := s.findTag(r.PathValue("id"))
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "NotFound", "Tag not found.")
+	}
+	return i
+}
+
+func (s *Server) listTags(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tags := s.tags
+	if tags == nil {
+		tags = []habitica.Tag{}
+	}
+	writeData(w, http.StatusOK, tags)
+}
+
+func (s *Server) createTag(w http.ResponseWriter, r *http.Request) {
+	var tag habitica.Tag
+	if !decode(w, r, &tag) {
+		return
+	}
+	if tag.Name == "" {
+		writeError(w, http.StatusBadRequest, "BadRequest", "Tag name cannot be empty.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	writeData(w, http.StatusCreated, s.addTag(tag.Name))
+}
+
+func (s *Server) getTag(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if i := s.tag(w, r); i >= 0 {
+		writeData(w, http.StatusOK, s.tags[i])
+	}
+}
+
+func (s *Server) updateTag(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := s.tag(w, r)
+	if i < 0 {
+		return
+	}
+	var tag habitica.Tag
+	if !decode(w, r, &tag) {
+		return
+	}
+	if tag.Name != "" {
+		s.tags[i].Name = tag.Name
+	}
+	writeData(w, http.StatusOK, s.tags[i])
+}
+
+// deleteTag removes the tag and untags every task that had it.
+func (s *Server) deleteTag(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := s.tag(w, r)
+	if i < 0 {
+		return
+	}
+	id := s.tags[i].ID
+	s.tags = slices.Delete(s.tags, i, i+1)
+	for _, t := range s.tasks {
+		t.Tags = slices.DeleteFunc(t.Tags, func(tagID string) bool { return tagID == id })
+	}
+	writeData(w, http.StatusOK, struct{}{})
+}
+
+func (s *Server) reorderTags(w http.ResponseWriter, r *http.Request) {
+	var reorder habitica.ReorderTag
+	if !decode(w, r, &reorder) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := s.findTag(reorder.TagID)
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "NotFound", "Tag not found.")
+		return
+	}
+	tag := s.tags[i]
+	s.tags = slices.Delete(s.tags, i, i+1)
+	to := reorder.To
+	if to < 0 || to > len(s.tags) {
+		to = len(s.tags)
+	}
+	s.tags = slices.Insert(s.tags, to, tag)
+	writeData(w, http.StatusOK, struct{}{})
+}
@@ -0,0 +1,77 @@
+package habiticatest_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/wfernandes/go-habitica"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestTags_CRUD(t *testing.T) {
+	RegisterTestingT(t)
+	fake, client := newFake(t)
+
+	created, err := client.Tags.Create(ctx, &habitica.Tag{Name: "work"})
+	Expect(err).ToNot(HaveOccurred())
+	id := created.Data.ID
+	Expect(id).ToNot(BeEmpty())
+
+	updated, err := client.Tags.Update(ctx, id, &habitica.Tag{Name: "office"})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(updated.Data.Name).To(Equal("office"))
+
+	got, err := client.Tags.Get(ctx, id)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(got.Data.Name).To(Equal("office"))
+
+	list, err := client.Tags.List(ctx)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(list.Data).To(Equal(fake.Tags()))
+
+	_, err = client.Tags.Delete(ctx, id)
+	Expect(err).ToNot(HaveOccurred())
+	_, err = client.Tags.Get(ctx, id)
+	Expect(errors.Is(err, habitica.ErrNotFound)).To(BeTrue())
+}
+
+func TestTags_Reorder(t *testing.T) {
+	RegisterTestingT(t)
+	fake, client := newFake(t)
+
+	a := fake.AddTag("a")
+	b := fake.AddTag("b")
+	c := fake.AddTag("c")
+
+	_, err := client.Tags.Reorder(ctx, &habitica.ReorderTag{TagID: c.ID, To: 0})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(fake.Tags()).To(Equal([]habitica.Tag{c, a, b}))
+}
+
+func TestTags_TaskTags(t *testing.T) {
+	RegisterTestingT(t)
+	fake, client := newFake(t)
+
+	tag := fake.AddTag("work")
+	task := fake.AddTask(habitica.Task{Text: "Write report", Type: habitica.TaskTypeTodo})
+
+	resp, err := client.Tasks.AddTag(ctx, task.ID, tag.ID)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.Data.Tags).To(Equal([]string{tag.ID}))
+
+	_, err = client.Tasks.AddTag(ctx, task.ID, "missing-tag")
+	Expect(errors.Is(err, habitica.ErrNotFound)).To(BeTrue())
+
+	resp, err = client.Tasks.DeleteTag(ctx, task.ID, tag.ID)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.Data.Tags).To(BeEmpty())
+
+	// Deleting a tag removes it from the tasks using it.
+	_, err = client.Tasks.AddTag(ctx, task.ID, tag.ID)
+	Expect(err).ToNot(HaveOccurred())
+	_, err = client.Tags.Delete(ctx, tag.ID)
+	Expect(err).ToNot(HaveOccurred())
+	got, _ := fake.Task(task.ID)
+	Expect(got.Tags).To(BeEmpty())
+}
@@ -0,0 +1,515 @@
+package habiticatest
+
+import (
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/wfernandes/go-habitica"
+)
+
+// AddTask stores a task as if it was created through the API and returns
+// it with its id and defaults filled in.
+func (s *Server) AddTask(task habitica.Task) habitica.Task {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return cloneTask(s.addTask(task))
+}
+
+// Tasks returns every task, including completed todos, in the order the
+// user sees them.
+func (s *Server) Tasks() []habitica.Task {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tasks := make([]habitica.Task, 0, len(s.tasks))
+	for _, t := range s.tasks {
+		tasks = append(tasks, cloneTask(t))
+	}
+	return tasks
+}
+
+// Task returns the task with the given id or alias.
+func (s *Server) Task(id string) (habitica.Task, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.findTask(id)
+	if t == nil {
+		return habitica.Task{}, false
+	}
+	return cloneTask(t), true
+}
+
+// cloneTask returns a copy of the task that shares no slices or pointers
+// with it, so the server's state cannot change through a returned task.
+func cloneTask(t *habitica.Task) habitica.Task {
+	c := *t
+	c.Tags = slices.Clone(t.Tags)
+	c.Checklist = slices.Clone(t.Checklist)
+	c.Reminders = slices.Clone(t.Reminders)
+	c.History = slices.Clone(t.History)
+	c.DaysOfMonth = slices.Clone(t.DaysOfMonth)
+	c.WeeksOfMonth = slices.Clone(t.WeeksOfMonth)
+	c.NextDue = slices.Clone(t.NextDue)
+	c.RequiresApproval = clonePtr(t.RequiresApproval)
+	c.Up = clonePtr(t.Up)
+	c.Down = clonePtr(t.Down)
+	c.YesterDaily = clonePtr(t.YesterDaily)
+	c.Repeat = clonePtr(t.Repeat)
+	c.Challenge = clonePtr(t.Challenge)
+	if t.Group != nil {
+		group := *t.Group
+		group.AssignedUsers = slices.Clone(t.Group.AssignedUsers)
+		group.Approval = clonePtr(t.Group.Approval)
+		c.Group = &group
+	}
+	return c
+}
+
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+// addTask stores a copy of the task, so the caller's slices are not changed.
+func (s *Server) addTask(task habitica.Task) *habitica.Task {
+	now := time.Now().UTC()
+	c := cloneTask(&task)
+	t := &c
+	t.ID = newID()
+	t.UserID = s.UserID
+	if t.Priority == 0 {
+		t.Priority = habitica.PriorityEasy
+	}
+	if t.Attribute == "" {
+		t.Attribute = habitica.AttributeStrength
+	}
+	if t.Tags == nil {
+		t.Tags = []string{}
+	}
+	if t.Checklist == nil {
+		t.Checklist = []habitica.ChecklistItem{}
+	}
+	for i := range t.Checklist {
+		if t.Checklist[i].Id == "" {
+			t.Checklist[i].Id = newID()
+		}
+	}
+	if t.Type == habitica.TaskTypeHabit {
+		if t.Up == nil {
+			t.Up = habitica.Bool(true)
+		}
+		if t.Down == nil {
+			t.Down = habitica.Bool(true)
+		}
+	}
+	if t.Type == habitica.TaskTypeReward && t.Value == 0 {
+		t.Value = 10
+	}
+	t.CreatedAt = now
+	t.UpdatedAt = now
+	s.tasks = append(s.tasks, t)
+	return t
+}
+
+func (s *Server) findTask(id string) *habitica.Task {
+	for _, t := range s.tasks {
+		if t.ID == id || (t.Alias != "" && t.Alias == id) {
+			return t
+		}
+	}
+	return nil
+}
+
+// task looks up the task in the path, writing a 404 response if it does not
+// exist. The caller must hold s.mu.
+func (s *Server) task(w http.ResponseWriter, r *http.Request) *habitica.Task {
+	t := s.findTask(r.PathValue("id"))
+	if t == nil {
+		writeError(w, http.StatusNotFound, "NotFound", "Task not found.")
+	}
+	return t
+}
+
+func (s *Server) listTasks(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	listType := habitica.TaskListType(r.URL.Query().Get("type"))
+	tasks := []habitica.Task{}
+	for _, t := range s.tasks {
+		if matchesListType(t, listType) {
+			tasks = append(tasks, *t)
+		}
+	}
+	writeData(w, http.StatusOK, tasks)
+}
+
+func matchesListType(t *habitica.Task, listType habitica.TaskListType) bool {
+	completedTodo := t.Type == habitica.TaskTypeTodo && t.Completed
+	switch listType {
+	case "":
+		return !completedTodo
+	case habitica.Habits:
+		return t.Type == habitica.TaskTypeHabit
+	case habitica.Dailys:
+		return t.Type == habitica.TaskTypeDaily
+	case habitica.Todos:
+		return t.Type == habitica.TaskTypeTodo && !completedTodo
+	case habitica.Rewards:
+		return t.Type == habitica.TaskTypeReward
+	case habitica.CompletedTodos:
+		return completedTodo
+	}
+	return false
+}
+
+func (s *Server) createTask(w http.ResponseWriter, r *http.Request) {
+	var task habitica.Task
+	if !decode(w, r, &task) {
+		return
+	}
+	switch task.Type {
+	case habitica.TaskTypeHabit, habitica.TaskTypeDaily, habitica.TaskTypeTodo, habitica.TaskTypeReward:
+	default:
+		writeError(w, http.StatusBadRequest, "BadRequest", "Task type must be one of habit, daily, todo or reward.")
+		return
+	}
+	if task.Text == "" {
+		writeError(w, http.StatusBadRequest, "BadRequest", "Task text cannot be empty.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	writeData(w, http.StatusCreated, s.addTask(task))
+}
+
+func (s *Server) getTask(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if t := s.task(w, r); t != nil {
+		writeData(w, http.StatusOK, t)
+	}
+}
+
+func (s *Server) updateTask(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.task(w, r)
+	if t == nil {
+		return
+	}
+
+	// Decoding over a copy only changes the fields that were sent, like the
+	// API does.
+	updated := *t
+	if !decode(w, r, &updated) {
+		return
+	}
+	updated.ID = t.ID
+	updated.UserID = t.UserID
+	updated.Type = t.Type
+	updated.CreatedAt = t.CreatedAt
+	updated.UpdatedAt = time.Now().UTC()
+	*t = updated
+	writeData(w, http.StatusOK, t)
+}
+
+func (s *Server) deleteTask(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.task(w, r)
+	if t == nil {
+		return
+	}
+	s.tasks = slices.DeleteFunc(s.tasks, func(other *habitica.Task) bool { return other == t })
+	writeData(w, http.StatusOK, struct{}{})
+}
+
+// scoreTask scores a task with a simplified version of the API's rules: the
+// task's value moves by a delta that shrinks as the value grows, and the
+// user gains experience and gold (or loses health) in proportion to the
+// delta and the task's priority.
+func (s *Server) scoreTask(w http.ResponseWriter, r *http.Request) {
+	direction := habitica.ScoreDirection(r.PathValue("direction"))
+	if direction != habitica.Up && direction != habitica.Down {
+		writeError(w, http.StatusBadRequest, "BadRequest", "Direction must be up or down.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.task(w, r)
+	if t == nil {
+		return
+	}
+	stats := &s.user.Stats
+	var tmp habitica.ScoreTmp
+
+	delta := 0.0
+	if t.Type == habitica.TaskTypeReward {
+		if direction == habitica.Up {
+			if stats.GP < t.Value {
+				writeError(w, http.StatusUnauthorized, "NotAuthorized", "Not Enough Gold")
+				return
+			}
+			stats.GP -= t.Value
+		}
+	} else {
+		delta = taskDelta(t, direction)
+		t.Value += delta
+		priority := float64(t.Priority)
+		if direction == habitica.Up {
+			initialLvl := stats.Lvl
+			stats.Exp += math.Round(delta * priority * 6)
+			stats.GP += round2(delta * priority)
+			for stats.Exp >= float64(stats.ToNextLevel) {
+				stats.Exp -= float64(stats.ToNextLevel)
+				stats.Lvl++
+				stats.ToNextLevel = toNextLevel(stats.Lvl)
+				stats.HP = float64(stats.MaxHealth)
+			}
+			if stats.Lvl > initialLvl {
+				tmp.LeveledUp = []byte(`{"initialLvl":` + strconv.Itoa(initialLvl) + `,"newLvl":` + strconv.Itoa(stats.Lvl) + `}`)
+			}
+		} else if t.Type == habitica.TaskTypeHabit {
+			stats.HP = math.Max(0, round2(stats.HP+delta*priority*2))
+		}
+	}
+
+	switch t.Type {
+	case habitica.TaskTypeHabit:
+		if direction == habitica.Up {
+			t.CounterUp++
+		} else {
+			t.CounterDown++
+		}
+	case habitica.TaskTypeDaily:
+		t.Completed = direction == habitica.Up
+		if direction == habitica.Up {
+			t.Streak++
+		} else if t.Streak > 0 {
+			t.Streak--
+		}
+	case habitica.TaskTypeTodo:
+		t.Completed = direction == habitica.Up
+		t.DateCompleted = time.Time{}
+		if t.Completed {
+			t.DateCompleted = time.Now().UTC()
+		}
+	}
+	t.UpdatedAt = time.Now().UTC()
+
+	writeData(w, http.StatusOK, habitica.ScoreResult{
+		Delta: delta,
+		HP:    stats.HP,
+		MP:    stats.MP,
+		Exp:   stats.Exp,
+		GP:    stats.GP,
+		Lvl:   stats.Lvl,
+		Class: string(stats.Class),
+		Tmp:   tmp,
+	})
+}
+
+// taskDelta is how much scoring moves the task's value. Completed checklist
+// items increase the reward for completing a daily or todo.
+func taskDelta(t *habitica.Task, direction habitica.ScoreDirection) float64 {
+	value := math.Max(-47.27, math.Min(21.27, t.Value))
+	delta := math.Pow(0.9747, value)
+	if direction == habitica.Down {
+		return -delta
+	}
+	if n := len(t.Checklist); n > 0 && t.Type != habitica.TaskTypeHabit {
+		completed := 0
+		for _, item := range t.Checklist {
+			if item.Completed {
+				completed++
+			}
+		}
+		delta *= 1 + float64(completed)/float64(n)
+	}
+	return delta
+}
+
+// toNextLevel is the experience needed to reach the level after lvl.
+func toNextLevel(lvl int) int {
+	l := float64(lvl)
+	return int(math.Round((0.25*l*l+10*l+139.75)/10) * 10)
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// moveTask moves a task within the tasks of the same type and returns the
+// new order of their ids. A position of -1 moves it to the bottom.
+func (s *Server) moveTask(w http.ResponseWriter, r *http.Request) {
+	position, err := strconv.Atoi(r.PathValue("position"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", "Position must be a number.")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.task(w, r)
+	if t == nil {
+		return
+	}
+
+	var same, others []*habitica.Task
+	for _, other := range s.tasks {
+		if other == t {
+			continue
+		}
+		if other.Type == t.Type {
+			same = append(same, other)
+		} else {
+			others = append(others, other)
+		}
+	}
+	if position < 0 || position > len(same) {
+		position = len(same)
+	}
+	same = slices.Insert(same, position, t)
+	s.tasks = append(others, same...)
+
+	order := make([]string, 0, len(same))
+	for _, other := range same {
+		order = append(order, other.ID)
+	}
+	writeData(w, http.StatusOK, order)
+}
+
+func (s *Server) addTaskTag(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.task(w, r)
+	if t == nil {
+		return
+	}
+	tagID := r.PathValue("tagID")
+	if s.findTag(tagID) < 0 {
+		writeError(w, http.StatusNotFound, "NotFound", "Tag not found.")
+		return
+	}
+	if slices.Contains(t.Tags, tagID) {
+		writeError(w, http.StatusBadRequest, "BadRequest", "The task is already tagged with given tag.")
+		return
+	}
+	t.Tags = append(t.Tags, tagID)
+	writeData(w, http.StatusOK, t)
+}
+
+func (s *Server) deleteTaskTag(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.task(w, r)
+	if t == nil {
+		return
+	}
+	i := slices.Index(t.Tags, r.PathValue("tagID"))
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "NotFound", "Tag not found.")
+		return
+	}
+	t.Tags = slices.Delete(t.Tags, i, i+1)
+	writeData(w, http.StatusOK, t)
+}
+
+// checklistItem looks up the checklist item in the path, writing a 404
+// response if it does not exist. The caller must hold s.mu.
+func checklistItem(w http.ResponseWriter, r *http.Request, t *habitica.Task) int {
+	i := slices.IndexFunc(t.Checklist, func(item habitica.ChecklistItem) bool {
+		return item.Id == r.PathValue("itemID")
+	})
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "NotFound", "Checklist item not found.")
+	}
+	return i
+}
+
+func (s *Server) addChecklistItem(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.task(w, r)
+	if t == nil {
+		return
+	}
+	if t.Type != habitica.TaskTypeDaily && t.Type != habitica.TaskTypeTodo {
+		writeError(w, http.StatusBadRequest, "BadRequest", "Checklists are only supported for dailies and todos.")
+		return
+	}
+	var item habitica.ChecklistItem
+	if !decode(w, r, &item) {
+		return
+	}
+	item.Id = newID()
+	t.Checklist = append(t.Checklist, item)
+	writeData(w, http.StatusOK, t)
+}
+
+func (s *Server) updateChecklistItem(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.task(w, r)
+	if t == nil {
+		return
+	}
+	i := checklistItem(w, r, t)
+	if i < 0 {
+		return
+	}
+	item := t.Checklist[i]
+	if !decode(w, r, &item) {
+		return
+	}
+	item.Id = t.Checklist[i].Id
+	t.Checklist[i] = item
+	writeData(w, http.StatusOK, t)
+}
+
+func (s *Server) deleteChecklistItem(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.task(w, r)
+	if t == nil {
+		return
+	}
+	i := checklistItem(w, r, t)
+	if i < 0 {
+		return
+	}
+	t.Checklist = slices.Delete(t.Checklist, i, i+1)
+	writeData(w, http.StatusOK, t)
+}
+
+func (s *Server) scoreChecklistItem(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.task(w, r)
+	if t == nil {
+		return
+	}
+	i := checklistItem(w, r, t)
+	if i < 0 {
+		return
+	}
+	t.Checklist[i].Completed = !t.Checklist[i].Completed
+	writeData(w, http.StatusOK, t)
+}
+
+func (s *Server) clearCompletedTodos(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tasks = slices.DeleteFunc(s.tasks, func(t *habitica.Task) bool {
+		return t.Type == habitica.TaskTypeTodo && t.Completed && t.Challenge == nil
+	})
+	writeData(w, http.StatusOK, struct{}{})
+}
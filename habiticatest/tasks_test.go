@@ -0,0 +1,239 @@
+package habiticatest_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/wfernandes/go-habitica"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestTasks_CRUD(t *testing.T) {
+	RegisterTestingT(t)
+	fake, client := newFake(t)
+
+	created, err := client.Tasks.Create(ctx, &habitica.Task{
+		Text: "Write report",
+		Type: habitica.TaskTypeTodo,
+	})
+	Expect(err).ToNot(HaveOccurred())
+	id := created.Data.ID
+	Expect(id).ToNot(BeEmpty())
+	Expect(created.Data.UserID).To(Equal(fake.UserID))
+	Expect(created.Data.Priority).To(Equal(habitica.PriorityEasy))
+
+	task := created.Data
+	task.Notes = "Due Friday"
+	updated, err := client.Tasks.Update(ctx, id, task)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(updated.Data.Notes).To(Equal("Due Friday"))
+
+	got, err := client.Tasks.Get(ctx, id)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(got.Data.Text).To(Equal("Write report"))
+	Expect(got.Data.Notes).To(Equal("Due Friday"))
+
+	_, err = client.Tasks.Delete(ctx, id)
+	Expect(err).ToNot(HaveOccurred())
+	_, err = client.Tasks.Get(ctx, id)
+	Expect(errors.Is(err, habitica.ErrNotFound)).To(BeTrue())
+	Expect(fake.Tasks()).To(BeEmpty())
+}
+
+func TestTasks_CreateValidates(t *testing.T) {
+	RegisterTestingT(t)
+	_, client := newFake(t)
+
+	_, err := client.Tasks.Create(ctx, &habitica.Task{Text: "No type"})
+	Expect(errors.Is(err, habitica.ErrBadRequest)).To(BeTrue())
+}
+
+func TestTasks_List(t *testing.T) {
+	RegisterTestingT(t)
+	fake, client := newFake(t)
+
+	fake.AddTask(habitica.Task{Text: "Floss", Type: habitica.TaskTypeHabit})
+	fake.AddTask(habitica.Task{Text: "Stretch", Type: habitica.TaskTypeDaily})
+	fake.AddTask(habitica.Task{Text: "Open", Type: habitica.TaskTypeTodo})
+	fake.AddTask(habitica.Task{Text: "Done", Type: habitica.TaskTypeTodo, Completed: true})
+
+	all, err := client.Tasks.List(ctx, nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(all.Data).To(HaveLen(3))
+
+	todos, err := client.Tasks.List(ctx, &habitica.TaskListOptions{Type: habitica.Todos})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(todos.Data).To(HaveLen(1))
+	Expect(todos.Data[0].Text).To(Equal("Open"))
+
+	completed, err := client.Tasks.List(ctx, &habitica.TaskListOptions{Type: habitica.CompletedTodos})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(completed.Data).To(HaveLen(1))
+	Expect(completed.Data[0].Text).To(Equal("Done"))
+}
+
+func TestTasks_Score(t *testing.T) {
+	RegisterTestingT(t)
+	fake, client := newFake(t)
+
+	todo := fake.AddTask(habitica.Task{Text: "Write report", Type: habitica.TaskTypeTodo, Priority: habitica.PriorityHard})
+	resp, err := client.Tasks.Score(ctx, todo.ID, habitica.Up)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.Data.Delta).To(Equal(1.0))
+	Expect(resp.Data.Exp).To(Equal(12.0))
+	Expect(resp.Data.GP).To(Equal(2.0))
+
+	got, _ := fake.Task(todo.ID)
+	Expect(got.Completed).To(BeTrue())
+	Expect(got.Value).To(Equal(1.0))
+	Expect(got.DateCompleted.IsZero()).To(BeFalse())
+	Expect(fake.User().Stats.Exp).To(Equal(12.0))
+
+	habit := fake.AddTask(habitica.Task{Text: "Junk food", Type: habitica.TaskTypeHabit})
+	resp, err = client.Tasks.Score(ctx, habit.ID, habitica.Down)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.Data.Delta).To(Equal(-1.0))
+	Expect(resp.Data.HP).To(Equal(48.0))
+	got, _ = fake.Task(habit.ID)
+	Expect(got.CounterDown).To(Equal(1))
+}
+
+func TestTasks_ScoreLevelUp(t *testing.T) {
+	RegisterTestingT(t)
+	fake, client := newFake(t)
+
+	stats := fake.User().Stats
+	stats.Exp = 149
+	stats.HP = 10
+	fake.SetStats(stats)
+
+	daily := fake.AddTask(habitica.Task{Text: "Stretch", Type: habitica.TaskTypeDaily})
+	resp, err := client.Tasks.Score(ctx, daily.ID, habitica.Up)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.Data.Lvl).To(Equal(2))
+	Expect(resp.Data.HP).To(Equal(50.0))
+	Expect(resp.Data.Tmp.LevelUp()).To(BeTrue())
+
+	got, _ := fake.Task(daily.ID)
+	Expect(got.Streak).To(Equal(1))
+	Expect(fake.User().Stats.ToNextLevel).To(Equal(160))
+}
+
+func TestTasks_ScoreReward(t *testing.T) {
+	RegisterTestingT(t)
+	fake, client := newFake(t)
+
+	reward := fake.AddTask(habitica.Task{Text: "Coffee", Type: habitica.TaskTypeReward, Value: 20})
+	_, err := client.Tasks.Score(ctx, reward.ID, habitica.Up)
+	Expect(errors.Is(err, habitica.ErrNotEnoughGold)).To(BeTrue())
+
+	stats := fake.User().Stats
+	stats.GP = 25
+	fake.SetStats(stats)
+	resp, err := client.Tasks.Score(ctx, reward.ID, habitica.Up)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.Data.GP).To(Equal(5.0))
+}
+
+func TestTasks_Checklist(t *testing.T) {
+	RegisterTestingT(t)
+	_, client := newFake(t)
+
+	created, err := client.Tasks.Create(ctx, &habitica.Task{Text: "Pack", Type: habitica.TaskTypeTodo})
+	Expect(err).ToNot(HaveOccurred())
+	id := created.Data.ID
+
+	resp, err := client.Tasks.AddChecklistItem(ctx, id, &habitica.ChecklistItem{Text: "Socks"})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.Data.Checklist).To(HaveLen(1))
+	item := resp.Data.Checklist[0]
+	Expect(item.Id).ToNot(BeEmpty())
+
+	item.Text = "Wool socks"
+	resp, err = client.Tasks.UpdateChecklistItem(ctx, id, &item)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.Data.Checklist[0].Text).To(Equal("Wool socks"))
+
+	resp, err = client.Tasks.ScoreChecklistItem(ctx, id, item.Id)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.Data.Checklist[0].Completed).To(BeTrue())
+
+	score, err := client.Tasks.Score(ctx, id, habitica.Up)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(score.Data.Delta).To(Equal(2.0))
+
+	resp, err = client.Tasks.DeleteChecklistItem(ctx, id, item.Id)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.Data.Checklist).To(BeEmpty())
+
+	_, err = client.Tasks.DeleteChecklistItem(ctx, id, item.Id)
+	Expect(errors.Is(err, habitica.ErrNotFound)).To(BeTrue())
+}
+
+func TestTasks_ReturnsCopies(t *testing.T) {
+	RegisterTestingT(t)
+	fake, client := newFake(t)
+
+	tag := fake.AddTag("travel")
+	checklist := []habitica.ChecklistItem{{Text: "Socks"}}
+	task := fake.AddTask(habitica.Task{
+		Text:      "Pack",
+		Type:      habitica.TaskTypeTodo,
+		Tags:      []string{tag.ID},
+		Checklist: checklist,
+	})
+	Expect(checklist[0].Id).To(BeEmpty())
+
+	snapshot, ok := fake.Task(task.ID)
+	Expect(ok).To(BeTrue())
+	_, err := client.Tasks.ScoreChecklistItem(ctx, task.ID, task.Checklist[0].Id)
+	Expect(err).ToNot(HaveOccurred())
+	_, err = client.Tags.Delete(ctx, tag.ID)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(snapshot.Checklist[0].Completed).To(BeFalse())
+	Expect(snapshot.Tags).To(Equal([]string{tag.ID}))
+
+	snapshot.Checklist[0].Text = "Changed"
+	got, _ := fake.Task(task.ID)
+	Expect(got.Checklist[0].Text).To(Equal("Socks"))
+	Expect(got.Checklist[0].Completed).To(BeTrue())
+	Expect(got.Tags).To(BeEmpty())
+}
+
+func TestTasks_MoveToPosition(t *testing.T) {
+	RegisterTestingT(t)
+	fake, client := newFake(t)
+
+	a := fake.AddTask(habitica.Task{Text: "A", Type: habitica.TaskTypeTodo})
+	b := fake.AddTask(habitica.Task{Text: "B", Type: habitica.TaskTypeTodo})
+	c := fake.AddTask(habitica.Task{Text: "C", Type: habitica.TaskTypeTodo})
+	fake.AddTask(habitica.Task{Text: "Habit", Type: habitica.TaskTypeHabit})
+
+	resp, err := client.Tasks.MoveToPosition(ctx, c.ID, 0)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.Data).To(Equal([]string{c.ID, a.ID, b.ID}))
+
+	resp, err = client.Tasks.MoveToPosition(ctx, c.ID, -1)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.Data).To(Equal([]string{a.ID, b.ID, c.ID}))
+
+	todos, err := client.Tasks.List(ctx, &habitica.TaskListOptions{Type: habitica.Todos})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(todos.Data[0].ID).To(Equal(a.ID))
+	Expect(todos.Data[2].ID).To(Equal(c.ID))
+}
+
+func TestTasks_ClearCompletedTodos(t *testing.T) {
+	RegisterTestingT(t)
+	fake, client := newFake(t)
+
+	open := fake.AddTask(habitica.Task{Text: "Open", Type: habitica.TaskTypeTodo})
+	fake.AddTask(habitica.Task{Text: "Done", Type: habitica.TaskTypeTodo, Completed: true})
+
+	_, err := client.Tasks.ClearCompletedTodos(ctx)
+	Expect(err).ToNot(HaveOccurred())
+	tasks := fake.Tasks()
+	Expect(tasks).To(HaveLen(1))
+	Expect(tasks[0].ID).To(Equal(open.ID))
+}
@@ -0,0 +1,121 @@
+package habitica
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Message is a private message in the user's inbox. UUID and Username
+// identify the sender, unless Sent is set in which case they identify the
+// recipient.
+type Message struct {
+	ID        string          `json:"id"`
+	Text      string          `json:"text"`
+	Timestamp time.Time       `json:"timestamp"`
+	UUID      string          `json:"uuid"`
+	User      string          `json:"user,omitempty"`
+	Username  string          `json:"username,omitempty"`
+	Likes     map[string]bool `json:"likes,omitempty"`
+	Flags     map[string]bool `json:"flags,omitempty"`
+	Sent      bool            `json:"sent"`
+}
+
+func (m *Message) UnmarshalJSON(data []byte) error {
+	type message Message
+	var raw struct {
+		message
+		Timestamp json.RawMessage `json:"timestamp"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	*m = Message(raw.message)
+	return unmarshalTimestamp(raw.Timestamp, &m.Timestamp)
+}
+
+// InboxListOptions pages through the inbox. Set Conversation to a user id to
+// only get the messages exchanged with that user.
+type InboxListOptions struct {
+	Page         int    `url:"page,omitempty"`
+	Conversation string `url:"conversation,omitempty"`
+}
+
+type SentMessage struct {
+	Message *Message `json:"message"`
+}
+
+type MessagesResponse = Envelope[[]Message]
+
+type SentMessageResponse = Envelope[*SentMessage]
+
+type InboxService struct {
+	client *HabiticaClient
+}
+
+func newInboxService(h *HabiticaClient) *InboxService {
+	return &InboxService{
+		client: h,
+	}
+}
+
+func (s *InboxService) Send(ctx context.Context, toUserID, message string) (*SentMessageResponse, error) {
+	body := map[string]string{
+		"message":  message,
+		"toUserId": toUserID,
+	}
+	req, err := s.client.NewRequest(http.MethodPost, "members/send-private-message", body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*SentMessage](ctx, s.client, req)
+}
+
+// Messages returns a page of the inbox, newest first. A nil opts returns the
+// first page.
+func (s *InboxService) Messages(ctx context.Context, opts *InboxListOptions) (*MessagesResponse, error) {
+	urlPath, err := addOptions("inbox/messages", opts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode options: %s", err)
+	}
+	req, err := s.client.NewRequest(http.MethodGet, urlPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[[]Message](ctx, s.client, req)
+}
+
+func (s *InboxService) Delete(ctx context.Context, id string) (*Envelope[json.RawMessage], error) {
+	req, err := s.client.NewRequest(http.MethodDelete, fmt.Sprintf("user/messages/%s", id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[json.RawMessage](ctx, s.client, req)
+}
+
+// Clear deletes every message in the inbox.
+func (s *InboxService) Clear(ctx context.Context) (*Envelope[json.RawMessage], error) {
+	req, err := s.client.NewRequest(http.MethodDelete, "user/messages", nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[json.RawMessage](ctx, s.client, req)
+}
+
+// MarkRead resets the user's unread private message count.
+func (s *InboxService) MarkRead(ctx context.Context) (*Envelope[json.RawMessage], error) {
+	req, err := s.client.NewRequest(http.MethodPost, "user/mark-pms-read", nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[json.RawMessage](ctx, s.client, req)
+}
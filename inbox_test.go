@@ -0,0 +1,126 @@
+package habitica_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/wfernandes/go-habitica"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestSend_PrivateMessage(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	var received map[string]string
+	mux.HandleFunc("/members/send-private-message", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&received)
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": {"message": {"id": "msg-1", "text": "Hi!", "timestamp": "2017-01-14T10:00:00.000Z", "uuid": "some-friend-id", "sent": true}}}`))
+	})
+	resp, err := client.Inbox.Send(ctx, "some-friend-id", "Hi!")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+	Expect(received).To(Equal(map[string]string{"message": "Hi!", "toUserId": "some-friend-id"}))
+	Expect(resp.Data.Message.ID).To(Equal("msg-1"))
+	Expect(resp.Data.Message.Sent).To(BeTrue())
+}
+
+func TestMessages_Inbox(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/inbox/messages", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(inboxResponse)
+	})
+	resp, err := client.Inbox.Messages(ctx, &habitica.InboxListOptions{Page: 1})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodGet))
+	Expect(request.URL.Query().Get("page")).To(Equal("1"))
+	Expect(resp.Data).To(HaveLen(1))
+
+	msg := resp.Data[0]
+	Expect(msg.ID).To(Equal("msg-2"))
+	Expect(msg.Text).To(Equal("Want to join our party?"))
+	Expect(msg.UUID).To(Equal("some-friend-id"))
+	Expect(msg.Username).To(Equal("friend"))
+	Expect(msg.Timestamp.Equal(time.UnixMilli(1484388000000))).To(BeTrue())
+	Expect(msg.Likes).To(HaveKeyWithValue("b0413351-405f-416f-8787-947ec1c85199", true))
+	Expect(msg.Sent).To(BeFalse())
+}
+
+func TestMessages_FirstPage(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/inbox/messages", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(inboxResponse)
+	})
+	_, err := client.Inbox.Messages(ctx, nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.URL.RawQuery).To(BeEmpty())
+}
+
+func TestInbox_Manage(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	requests := []string{}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": {}}`))
+	}
+	mux.HandleFunc("/user/messages", handler)
+	mux.HandleFunc("/user/messages/", handler)
+	mux.HandleFunc("/user/mark-pms-read", handler)
+
+	_, err := client.Inbox.Delete(ctx, "msg-2")
+	Expect(err).ToNot(HaveOccurred())
+	_, err = client.Inbox.Clear(ctx)
+	Expect(err).ToNot(HaveOccurred())
+	_, err = client.Inbox.MarkRead(ctx)
+	Expect(err).ToNot(HaveOccurred())
+
+	Expect(requests).To(Equal([]string{
+		"DELETE /user/messages/msg-2",
+		"DELETE /user/messages",
+		"POST /user/mark-pms-read",
+	}))
+}
+
+var inboxResponse = []byte(`
+{
+    "success": true,
+    "data": [
+        {
+            "id": "msg-2",
+            "text": "Want to join our party?",
+            "timestamp": 1484388000000,
+            "likes": {
+                "b0413351-405f-416f-8787-947ec1c85199": true
+            },
+            "flags": {},
+            "uuid": "some-friend-id",
+            "user": "Friend",
+            "username": "friend",
+            "sent": false
+        }
+    ],
+    "notifications": []
+}`)
@@ -2,24 +2,60 @@ package integration_test
 
 import (
 	"context"
+	"errors"
 	"os"
+	"path/filepath"
 	"testing"
 
 	. "github.com/onsi/gomega"
 	"github.com/wfernandes/go-habitica"
+	"github.com/wfernandes/go-habitica/recorder"
 )
 
-func TestIntegration_CRUDTask(t *testing.T) {
-	RegisterTestingT(t)
-	if testing.Short() {
+// newClient returns a client that records the API interactions to
+// testdata/<cassette>.json when USER_ID and API_TOKEN are set, and replays
+// them otherwise. Without credentials the test is skipped unless the cassette
+// has been recorded. It also returns the user id the responses will hold.
+func newClient(t *testing.T, cassette string) (*habitica.HabiticaClient, string) {
+	userID := os.Getenv("USER_ID")
+	apiToken := os.Getenv("API_TOKEN")
+	path := filepath.Join("testdata", cassette+".json")
+
+	mode := recorder.Record
+	if userID == "" || apiToken == "" {
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			t.Skip("skipping integration: set USER_ID and API_TOKEN to record " + path)
+		}
+		mode = recorder.Replay
+		userID = recorder.PlaceholderUserID
+		apiToken = "replayed-api-token"
+	} else if testing.Short() {
 		t.Skip("skipping integration")
 	}
 
-	userID := os.Getenv("USER_ID")
-	apiToken := os.Getenv("API_TOKEN")
+	rec, err := recorder.New(path, mode,
+		recorder.WithScrub(userID, recorder.PlaceholderUserID),
+		recorder.WithScrub(apiToken, recorder.Redacted))
+	if err != nil {
+		t.Fatalf("error creating recorder: %s", err)
+	}
+	t.Cleanup(func() {
+		err := rec.Stop()
+		if err != nil {
+			t.Errorf("error stopping recorder: %s", err)
+		}
+	})
 
-	client, err := habitica.New(userID, apiToken)
-	Expect(err).ToNot(HaveOccurred(), "error creating client")
+	client, err := habitica.New(userID, apiToken, habitica.WithHttpClient(rec.Client()))
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+	return client, userID
+}
+
+func TestIntegration_CRUDTask(t *testing.T) {
+	RegisterTestingT(t)
+	client, userID := newClient(t, "crud_task")
 
 	// create a task
 	ctx := context.Background()
@@ -27,9 +63,9 @@ func TestIntegration_CRUDTask(t *testing.T) {
 	task := &habitica.Task{
 		Text:  "This is a test task",
 		Notes: "Integration test task notes",
-		Type:  "todo",
+		Type:  habitica.TaskTypeTodo,
 	}
-	taskResponse, err = client.Tasks.Create(ctx, task)
+	taskResponse, err := client.Tasks.Create(ctx, task)
 	Expect(err).ToNot(HaveOccurred())
 	Expect(taskResponse.Success).To(BeTrue(), "create did not succeed")
 	task = taskResponse.Data
@@ -54,26 +90,18 @@ func TestIntegration_CRUDTask(t *testing.T) {
 	Expect(taskResponse.Success).To(BeTrue(), "delete did not succeed")
 
 	// confirm that the task has been deleted
-	taskResponse, err = client.Tasks.Get(ctx, task.ID)
-	Expect(err).ToNot(HaveOccurred())
-	Expect(taskResponse.Success).To(BeFalse())
-	Expect(taskResponse.Error).To(Equal("NotFound"))
-	Expect(taskResponse.Message).To(Equal("Task not found."))
+	_, err = client.Tasks.Get(ctx, task.ID)
+	Expect(errors.Is(err, habitica.ErrNotFound)).To(BeTrue())
+	var errResp *habitica.ErrorResponse
+	Expect(errors.As(err, &errResp)).To(BeTrue())
+	Expect(errResp.Message).To(Equal("Task not found."))
 
 }
 
 func TestIntegration_UsersTasks(t *testing.T) {
-	if testing.Short() {
-		t.Skip("skipping integration")
-	}
-	userID := os.Getenv("USER_ID")
-	apiToken := os.Getenv("API_TOKEN")
+	client, _ := newClient(t, "users_tasks")
 
-	client, err := habitica.New(userID, apiToken)
-	if err != nil {
-		t.Errorf("error creating client: %s", err)
-	}
-	tasks, err := client.Tasks.List(context.Background())
+	tasks, err := client.Tasks.List(context.Background(), nil)
 	if err != nil {
 		t.Errorf("error retrieving list of tasks: %s", err)
 	}
@@ -0,0 +1,172 @@
+package habitica
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Items is the user's inventory. The maps are keyed by item key and hold
+// the owned count, except Pets which holds the pet's feeding progress and
+// Mounts which is set for every owned mount.
+type Items struct {
+	Gear            Gear            `json:"gear"`
+	Eggs            map[string]int  `json:"eggs,omitempty"`
+	HatchingPotions map[string]int  `json:"hatchingPotions,omitempty"`
+	Food            map[string]int  `json:"food,omitempty"`
+	Quests          map[string]int  `json:"quests,omitempty"`
+	Pets            map[string]int  `json:"pets,omitempty"`
+	Mounts          map[string]bool `json:"mounts,omitempty"`
+	CurrentPet      string          `json:"currentPet,omitempty"`
+	CurrentMount    string          `json:"currentMount,omitempty"`
+}
+
+type Gear struct {
+	Owned    map[string]bool `json:"owned,omitempty"`
+	Equipped Outfit          `json:"equipped"`
+	Costume  Outfit          `json:"costume"`
+}
+
+// Outfit holds the key of the gear worn in each slot.
+type Outfit struct {
+	Weapon        string `json:"weapon,omitempty"`
+	Armor         string `json:"armor,omitempty"`
+	Head          string `json:"head,omitempty"`
+	Shield        string `json:"shield,omitempty"`
+	Back          string `json:"back,omitempty"`
+	Body          string `json:"body,omitempty"`
+	HeadAccessory string `json:"headAccessory,omitempty"`
+	Eyewear       string `json:"eyewear,omitempty"`
+}
+
+// GearItem describes a piece of gear.
+type GearItem struct {
+	Key   string  `json:"key"`
+	Text  string  `json:"text"`
+	Notes string  `json:"notes,omitempty"`
+	Type  string  `json:"type"`
+	Klass string  `json:"klass,omitempty"`
+	Set   string  `json:"set,omitempty"`
+	Value float64 `json:"value"`
+	Str   float64 `json:"str"`
+	Int   float64 `json:"int"`
+	Per   float64 `json:"per"`
+	Con   float64 `json:"con"`
+}
+
+// EquipType is what InventoryService.Equip equips.
+type EquipType string
+
+const (
+	EquipGear    EquipType = "equipped"
+	EquipCostume EquipType = "costume"
+	EquipPet     EquipType = "pet"
+	EquipMount   EquipType = "mount"
+)
+
+// SellType is the kind of item InventoryService.Sell sells.
+type SellType string
+
+const (
+	SellEggs            SellType = "eggs"
+	SellHatchingPotions SellType = "hatchingPotions"
+	SellFood            SellType = "food"
+)
+
+type sellOptions struct {
+	Amount int `url:"amount,omitempty"`
+}
+
+type ItemsResponse = Envelope[*Items]
+
+// FeedResponse holds the pet's feeding progress after feeding, or -1 once it
+// has grown into a mount.
+type FeedResponse = Envelope[int]
+
+type GearItemResponse = Envelope[*GearItem]
+
+type InventoryService struct {
+	client *HabiticaClient
+}
+
+func newInventoryService(h *HabiticaClient) *InventoryService {
+	return &InventoryService{
+		client: h,
+	}
+}
+
+// List returns the items the user owns.
+func (s *InventoryService) List(ctx context.Context) (*ItemsResponse, error) {
+	resp, err := s.client.User.Get(ctx, "items")
+	if err != nil {
+		return nil, err
+	}
+
+	items := &ItemsResponse{
+		Success:       resp.Success,
+		Notifications: resp.Notifications,
+		UserV:         resp.UserV,
+		AppVersion:    resp.AppVersion,
+		Message:       resp.Message,
+		Response:      resp.Response,
+	}
+	if resp.Data != nil {
+		items.Data = &resp.Data.Items
+	}
+	return items, nil
+}
+
+func (s *InventoryService) Hatch(ctx context.Context, egg, hatchingPotion string) (*ItemsResponse, error) {
+	req, err := s.client.NewRequest(http.MethodPost, fmt.Sprintf("user/hatch/%s/%s", egg, hatchingPotion), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*Items](ctx, s.client, req)
+}
+
+// Feed feeds a pet such as "Wolf-Base" with a food such as "Meat".
+func (s *InventoryService) Feed(ctx context.Context, pet, food string) (*FeedResponse, error) {
+	req, err := s.client.NewRequest(http.MethodPost, fmt.Sprintf("user/feed/%s/%s", pet, food), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[int](ctx, s.client, req)
+}
+
+// Equip equips gear, a costume piece, a pet or a mount. Equipping the item
+// that is already equipped unequips it.
+func (s *InventoryService) Equip(ctx context.Context, equipType EquipType, key string) (*ItemsResponse, error) {
+	req, err := s.client.NewRequest(http.MethodPost, fmt.Sprintf("user/equip/%s/%s", equipType, key), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*Items](ctx, s.client, req)
+}
+
+// Sell sells amount of an item for gold. An amount of 0 sells one.
+func (s *InventoryService) Sell(ctx context.Context, sellType SellType, key string, amount int) (*UserResponse, error) {
+	urlPath, err := addOptions(fmt.Sprintf("user/sell/%s/%s", sellType, key), &sellOptions{Amount: amount})
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode options: %s", err)
+	}
+	req, err := s.client.NewRequest(http.MethodPost, urlPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*User](ctx, s.client, req)
+}
+
+// OpenMysteryItem opens the oldest subscriber mystery item and returns the
+// gear it contained.
+func (s *InventoryService) OpenMysteryItem(ctx context.Context) (*GearItemResponse, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "user/open-mystery-item", nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*GearItem](ctx, s.client, req)
+}
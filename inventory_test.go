@@ -0,0 +1,128 @@
+package habitica_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/wfernandes/go-habitica"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestList_Items(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(userResponse)
+	})
+	resp, err := client.Inventory.List(ctx)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.URL.Query().Get("userFields")).To(Equal("items"))
+
+	items := resp.Data
+	Expect(items.Eggs).To(HaveKeyWithValue("Wolf", 2))
+	Expect(items.HatchingPotions).To(HaveKeyWithValue("Base", 1))
+	Expect(items.Food).To(HaveKeyWithValue("Meat", 3))
+	Expect(items.Pets).To(HaveKeyWithValue("Wolf-Base", 5))
+	Expect(items.Mounts).To(HaveKeyWithValue("Wolf-Base", true))
+	Expect(items.Mounts).To(HaveKeyWithValue("Fox-Base", false))
+	Expect(items.Gear.Owned).To(HaveKeyWithValue("weapon_wizard_1", true))
+	Expect(items.Gear.Equipped).To(Equal(habitica.Outfit{Weapon: "weapon_wizard_1", Armor: "armor_base_0"}))
+	Expect(items.Gear.Costume.Armor).To(Equal("armor_base_0"))
+	Expect(items.CurrentPet).To(Equal("Wolf-Base"))
+}
+
+func TestHatch_Pet(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/user/hatch/Wolf/Base", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": {"eggs": {"Wolf": 1}, "hatchingPotions": {"Base": 0}, "pets": {"Wolf-Base": 5}}, "message": "Your egg hatched! Visit your stable to equip your pet."}`))
+	})
+	resp, err := client.Inventory.Hatch(ctx, "Wolf", "Base")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+	Expect(resp.Data.Eggs).To(HaveKeyWithValue("Wolf", 1))
+	Expect(resp.Message).To(ContainSubstring("hatched"))
+}
+
+func TestFeed_Pet(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/user/feed/Wolf-Base/Meat", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": 10, "message": "Wolf really likes the Meat!"}`))
+	})
+	resp, err := client.Inventory.Feed(ctx, "Wolf-Base", "Meat")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+	Expect(resp.Data).To(Equal(10))
+	Expect(resp.Message).To(Equal("Wolf really likes the Meat!"))
+}
+
+func TestEquip_Item(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/user/equip/costume/armor_base_0", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": {"gear": {"costume": {"armor": "armor_base_0"}}}}`))
+	})
+	resp, err := client.Inventory.Equip(ctx, habitica.EquipCostume, "armor_base_0")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+	Expect(resp.Data.Gear.Costume.Armor).To(Equal("armor_base_0"))
+}
+
+func TestSell_Item(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/user/sell/eggs/Wolf", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": {"stats": {"gp": 91.2}, "items": {"eggs": {"Wolf": 0}}}}`))
+	})
+	resp, err := client.Inventory.Sell(ctx, habitica.SellEggs, "Wolf", 2)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+	Expect(request.URL.Query().Get("amount")).To(Equal("2"))
+	Expect(resp.Data.Stats.GP).To(Equal(91.2))
+	Expect(resp.Data.Items.Eggs).To(HaveKeyWithValue("Wolf", 0))
+}
+
+func TestOpenMysteryItem(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/user/open-mystery-item", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": {"key": "head_mystery_201701", "text": "Winter Hat", "type": "head", "set": "mystery-201701", "value": 0}}`))
+	})
+	resp, err := client.Inventory.OpenMysteryItem(ctx)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+	Expect(resp.Data.Key).To(Equal("head_mystery_201701"))
+	Expect(resp.Data.Type).To(Equal("head"))
+}
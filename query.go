@@ -0,0 +1,87 @@
+package habitica
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// addOptions encodes the fields of opts tagged with `url:"name"` as query
+// parameters of urlPath. Fields tagged with omitempty are skipped when they
+// hold their zero value. opts may be a nil pointer.
+func addOptions(urlPath string, opts interface{}) (string, error) {
+	v := reflect.ValueOf(opts)
+	if opts == nil || (v.Kind() == reflect.Ptr && v.IsNil()) {
+		return urlPath, nil
+	}
+	v = reflect.Indirect(v)
+	if v.Kind() != reflect.Struct {
+		return "", fmt.Errorf("options must be a struct, got %s", v.Kind())
+	}
+
+	values := url.Values{}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		tag := field.Tag.Get("url")
+		if tag == "" || tag == "-" || !field.IsExported() {
+			continue
+		}
+		name, tagOpts, _ := strings.Cut(tag, ",")
+		fv := v.Field(i)
+		if tagOpts == "omitempty" && fv.IsZero() {
+			continue
+		}
+
+		if fv.Kind() == reflect.Slice {
+			for j := 0; j < fv.Len(); j++ {
+				s, err := queryValue(fv.Index(j))
+				if err != nil {
+					return "", fmt.Errorf("field %s: %s", field.Name, err)
+				}
+				values.Add(name, s)
+			}
+			continue
+		}
+		s, err := queryValue(fv)
+		if err != nil {
+			return "", fmt.Errorf("field %s: %s", field.Name, err)
+		}
+		values.Set(name, s)
+	}
+
+	if len(values) == 0 {
+		return urlPath, nil
+	}
+	sep := "?"
+	if strings.Contains(urlPath, "?") {
+		sep = "&"
+	}
+	return urlPath + sep + values.Encode(), nil
+}
+
+func queryValue(v reflect.Value) (string, error) {
+	if t, ok := v.Interface().(time.Time); ok {
+		return t.Format(time.RFC3339), nil
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return "", nil
+		}
+		return queryValue(v.Elem())
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), nil
+	}
+	return "", fmt.Errorf("unsupported kind %s", v.Kind())
+}
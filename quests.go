@@ -0,0 +1,108 @@
+package habitica
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+// GroupQuest is the state of a group's current quest.
+type GroupQuest struct {
+	Key    string `json:"key,omitempty"`
+	Active bool   `json:"active"`
+	Leader string `json:"leader,omitempty"`
+
+	// Members maps each invited member to their RSVP: nil while pending,
+	// true once accepted and false if rejected.
+	Members  map[string]*bool `json:"members,omitempty"`
+	Progress QuestProgress    `json:"progress"`
+}
+
+// QuestProgress is the progress of the party on a boss or collection quest.
+type QuestProgress struct {
+	HP      float64        `json:"hp,omitempty"`
+	Rage    float64        `json:"rage,omitempty"`
+	Collect map[string]int `json:"collect,omitempty"`
+}
+
+// Accepted returns the ids of the members who accepted the quest.
+func (q GroupQuest) Accepted() []string {
+	return q.membersWhere(func(rsvp *bool) bool { return rsvp != nil && *rsvp })
+}
+
+// Pending returns the ids of the members who have not answered the invite.
+func (q GroupQuest) Pending() []string {
+	return q.membersWhere(func(rsvp *bool) bool { return rsvp == nil })
+}
+
+// Rejected returns the ids of the members who rejected the quest.
+func (q GroupQuest) Rejected() []string {
+	return q.membersWhere(func(rsvp *bool) bool { return rsvp != nil && !*rsvp })
+}
+
+func (q GroupQuest) membersWhere(match func(*bool) bool) []string {
+	ids := []string{}
+	for id, rsvp := range q.Members {
+		if match(rsvp) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+type QuestResponse = Envelope[*GroupQuest]
+
+type QuestService struct {
+	client *HabiticaClient
+}
+
+func newQuestService(h *HabiticaClient) *QuestService {
+	return &QuestService{
+		client: h,
+	}
+}
+
+// Invite invites the group to the quest with the given key from the
+// user's quest scrolls.
+func (s *QuestService) Invite(ctx context.Context, groupID, questKey string) (*QuestResponse, error) {
+	return s.action(ctx, groupID, fmt.Sprintf("invite/%s", questKey))
+}
+
+func (s *QuestService) Accept(ctx context.Context, groupID string) (*QuestResponse, error) {
+	return s.action(ctx, groupID, "accept")
+}
+
+func (s *QuestService) Reject(ctx context.Context, groupID string) (*QuestResponse, error) {
+	return s.action(ctx, groupID, "reject")
+}
+
+// ForceStart starts the quest without waiting for the pending members.
+func (s *QuestService) ForceStart(ctx context.Context, groupID string) (*QuestResponse, error) {
+	return s.action(ctx, groupID, "force-start")
+}
+
+// Cancel cancels a quest that has not started yet.
+func (s *QuestService) Cancel(ctx context.Context, groupID string) (*QuestResponse, error) {
+	return s.action(ctx, groupID, "cancel")
+}
+
+// Abort ends an active quest, losing its progress.
+func (s *QuestService) Abort(ctx context.Context, groupID string) (*QuestResponse, error) {
+	return s.action(ctx, groupID, "abort")
+}
+
+// Leave leaves an active quest.
+func (s *QuestService) Leave(ctx context.Context, groupID string) (*QuestResponse, error) {
+	return s.action(ctx, groupID, "leave")
+}
+
+func (s *QuestService) action(ctx context.Context, groupID, action string) (*QuestResponse, error) {
+	req, err := s.client.NewRequest(http.MethodPost, fmt.Sprintf("groups/%s/quests/%s", groupID, action), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*GroupQuest](ctx, s.client, req)
+}
@@ -0,0 +1,119 @@
+package habitica_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/wfernandes/go-habitica"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestQuest_ParsedFromParty(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/groups/party", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write(partyWithQuestResponse)
+	})
+	resp, err := client.Groups.Get(ctx, habitica.PartyID)
+	Expect(err).ToNot(HaveOccurred())
+
+	quest := resp.Data.Quest
+	Expect(quest).ToNot(BeNil())
+	Expect(quest.Key).To(Equal("dilatory"))
+	Expect(quest.Active).To(BeTrue())
+	Expect(quest.Leader).To(Equal("member-a"))
+	Expect(quest.Progress.HP).To(Equal(420.5))
+	Expect(quest.Progress.Rage).To(Equal(12.0))
+	Expect(quest.Progress.Collect).To(HaveKeyWithValue("soapBars", 7))
+	Expect(quest.Accepted()).To(Equal([]string{"member-a", "member-b"}))
+	Expect(quest.Pending()).To(Equal([]string{"member-c"}))
+	Expect(quest.Rejected()).To(Equal([]string{"member-d"}))
+}
+
+func TestQuest_Invite(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/groups/party/quests/invite/dilatory", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": {"key": "dilatory", "active": false, "leader": "member-a", "members": {"member-a": true, "member-b": null}, "progress": {}}}`))
+	})
+	resp, err := client.Quests.Invite(ctx, habitica.PartyID, "dilatory")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+	Expect(resp.Data.Key).To(Equal("dilatory"))
+	Expect(resp.Data.Pending()).To(Equal([]string{"member-b"}))
+}
+
+func TestQuest_Lifecycle(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	requests := []string{}
+	mux.HandleFunc("/groups/some-group-id/quests/", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": {"key": "dilatory", "progress": {}}}`))
+	})
+
+	actions := []func() (*habitica.QuestResponse, error){
+		func() (*habitica.QuestResponse, error) { return client.Quests.Accept(ctx, "some-group-id") },
+		func() (*habitica.QuestResponse, error) { return client.Quests.Reject(ctx, "some-group-id") },
+		func() (*habitica.QuestResponse, error) { return client.Quests.ForceStart(ctx, "some-group-id") },
+		func() (*habitica.QuestResponse, error) { return client.Quests.Cancel(ctx, "some-group-id") },
+		func() (*habitica.QuestResponse, error) { return client.Quests.Abort(ctx, "some-group-id") },
+		func() (*habitica.QuestResponse, error) { return client.Quests.Leave(ctx, "some-group-id") },
+	}
+	for _, action := range actions {
+		resp, err := action()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Data.Key).To(Equal("dilatory"))
+	}
+
+	Expect(requests).To(Equal([]string{
+		"POST /groups/some-group-id/quests/accept",
+		"POST /groups/some-group-id/quests/reject",
+		"POST /groups/some-group-id/quests/force-start",
+		"POST /groups/some-group-id/quests/cancel",
+		"POST /groups/some-group-id/quests/abort",
+		"POST /groups/some-group-id/quests/leave",
+	}))
+}
+
+var partyWithQuestResponse = []byte(`
+{
+    "success": true,
+    "data": {
+        "id": "e7b3dd5b-0b8a-4e2c-8c2a-5a9d3b8c1f2e",
+        "name": "Team Party",
+        "type": "party",
+        "leader": "member-a",
+        "quest": {
+            "key": "dilatory",
+            "active": true,
+            "leader": "member-a",
+            "members": {
+                "member-a": true,
+                "member-b": true,
+                "member-c": null,
+                "member-d": false
+            },
+            "progress": {
+                "hp": 420.5,
+                "rage": 12,
+                "collect": {
+                    "soapBars": 7
+                }
+            }
+        }
+    },
+    "notifications": []
+}`)
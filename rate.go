@@ -0,0 +1,195 @@
+package habitica
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	headerRateLimit     = "X-RateLimit-Limit"
+	headerRateRemaining = "X-RateLimit-Remaining"
+	headerRateReset     = "X-RateLimit-Reset"
+	headerRetryAfter    = "Retry-After"
+
+	retryBaseDelay = 500 * time.Millisecond
+	retryMaxDelay  = 30 * time.Second
+)
+
+// Rate is the rate limit state reported by the API in the X-RateLimit-*
+// headers of a response.
+type Rate struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// resetTimeLayouts are the formats the reset header has been seen in. The
+// last one is how javascript stringifies a Date.
+var resetTimeLayouts = []string{
+	time.RFC3339,
+	http.TimeFormat,
+	time.RFC1123Z,
+	"Mon Jan 02 2006 15:04:05 GMT-0700",
+}
+
+func parseRate(resp *http.Response) Rate {
+	var rate Rate
+	if v := resp.Header.Get(headerRateLimit); v != "" {
+		rate.Limit, _ = strconv.Atoi(v)
+	}
+	if v := resp.Header.Get(headerRateRemaining); v != "" {
+		rate.Remaining, _ = strconv.Atoi(v)
+	}
+	if v := resp.Header.Get(headerRateReset); v != "" {
+		rate.Reset = parseResetTime(v)
+	}
+	return rate
+}
+
+func parseResetTime(v string) time.Time {
+	if secs, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.Unix(secs, 0)
+	}
+	// Drop a trailing "(Coordinated Universal Time)" style zone name.
+	v, _, _ = strings.Cut(v, "(")
+	v = strings.TrimSpace(v)
+	for _, layout := range resetTimeLayouts {
+		t, err := time.Parse(layout, v)
+		if err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func parseRetryAfter(resp *http.Response) time.Duration {
+	v := resp.Header.Get(headerRetryAfter)
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
+
+// WithRateLimiter makes the client wait for the rate limit to reset before
+// sending a request once the last response reported no remaining requests.
+func WithRateLimiter() ClientOpt {
+	return func(h *HabiticaClient) {
+		h.limitRate = true
+	}
+}
+
+// WithRetry makes the client retry idempotent requests up to maxRetries
+// times when they are rate limited or fail with a 5xx status. Retries wait
+// for Retry-After if present, otherwise use jittered exponential backoff.
+// AuthService.UpdateAPIToken is never retried.
+func WithRetry(maxRetries int) ClientOpt {
+	return func(h *HabiticaClient) {
+		h.maxRetries = maxRetries
+	}
+}
+
+// Rate returns the rate limit state from the most recent response.
+func (h *HabiticaClient) Rate() Rate {
+	h.rateMu.Lock()
+	defer h.rateMu.Unlock()
+	return h.rate
+}
+
+func (h *HabiticaClient) setRate(rate Rate) {
+	if rate.Limit == 0 && rate.Reset.IsZero() {
+		return
+	}
+	h.rateMu.Lock()
+	defer h.rateMu.Unlock()
+	h.rate = rate
+}
+
+// waitForRate blocks until the rate limit resets if there are no requests
+// remaining. It fails right away if the context expires before the reset.
+func (h *HabiticaClient) waitForRate(ctx context.Context) error {
+	rate := h.Rate()
+	if rate.Limit == 0 || rate.Remaining > 0 {
+		return nil
+	}
+	wait := time.Until(rate.Reset)
+	if wait <= 0 {
+		return nil
+	}
+	err := sleep(ctx, wait)
+	if err != nil {
+		return fmt.Errorf("rate limit resets at %s: %w", rate.Reset, ErrRateLimited)
+	}
+	return nil
+}
+
+type noRetryKey struct{}
+
+// withoutRetry returns a context under which Do sends a request only once,
+// for requests that must not be repeated even though their method is
+// idempotent.
+func withoutRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetryKey{}, true)
+}
+
+func retryDisabled(ctx context.Context) bool {
+	disabled, _ := ctx.Value(noRetryKey{}).(bool)
+	return disabled
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func shouldRetry(errResp *ErrorResponse) bool {
+	switch errResp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff returns how long to wait before the given retry attempt. A
+// Retry-After that is missing, invalid or already past falls back to the
+// exponential backoff, so a throttled API is not retried right away.
+func backoff(attempt int, errResp *ErrorResponse) time.Duration {
+	if errResp.RetryAfter > 0 {
+		return errResp.RetryAfter
+	}
+	d := retryBaseDelay << attempt
+	if d <= 0 || d > retryMaxDelay {
+		d = retryMaxDelay
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// sleep waits for d, returning an error if ctx is done first or its deadline
+// would pass before d elapses.
+func sleep(ctx context.Context, d time.Duration) error {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d {
+		return context.DeadlineExceeded
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
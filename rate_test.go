@@ -0,0 +1,185 @@
+package habitica_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/wfernandes/go-habitica"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestRate_ParsedFromHeaders(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tags/some-tag-id", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "30")
+		w.Header().Set("X-RateLimit-Remaining", "29")
+		w.Header().Set("X-RateLimit-Reset", "Wed Jun 15 2022 12:00:30 GMT+0000 (Coordinated Universal Time)")
+		w.WriteHeader(http.StatusOK)
+		w.Write(tagResponse)
+	})
+	resp, err := client.Tags.Get(ctx, "some-tag-id")
+	Expect(err).ToNot(HaveOccurred())
+
+	rate := resp.Response.Rate
+	Expect(rate.Limit).To(Equal(30))
+	Expect(rate.Remaining).To(Equal(29))
+	Expect(rate.Reset.Equal(time.Date(2022, time.June, 15, 12, 0, 30, 0, time.UTC))).To(BeTrue())
+	Expect(client.Rate()).To(Equal(rate))
+}
+
+func TestRate_ErrorIncludesRetryAfter(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tags", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "30")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("Retry-After", "12")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	_, err := client.Tags.List(ctx)
+	Expect(habitica.IsRateLimited(err)).To(BeTrue())
+
+	errResp := err.(*habitica.ErrorResponse)
+	Expect(errResp.RetryAfter).To(Equal(12 * time.Second))
+	Expect(errResp.Rate.Remaining).To(Equal(0))
+}
+
+func TestRetry_RetriesIdempotentRequests(t *testing.T) {
+	RegisterTestingT(t)
+	calls := 0
+	c, teardown := retryClient(3, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(taskResponse)
+	})
+	defer teardown()
+
+	resp, err := c.Tasks.Update(context.Background(), "some-task-id", &habitica.Task{Text: "retried"})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.Data.Text).To(Equal("API Trial"))
+	Expect(calls).To(Equal(3))
+}
+
+func TestRetry_GivesUpAfterMaxRetries(t *testing.T) {
+	RegisterTestingT(t)
+	calls := 0
+	c, teardown := retryClient(2, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer teardown()
+
+	_, err := c.Tasks.Get(context.Background(), "some-task-id")
+	Expect(err).To(MatchError(habitica.ErrServer))
+	Expect(calls).To(Equal(3))
+}
+
+func TestRetry_BacksOffWhenRetryAfterIsInvalid(t *testing.T) {
+	RegisterTestingT(t)
+	for _, retryAfter := range []string{"soon", "Wed, 15 Jun 2022 12:00:30 GMT"} {
+		calls := 0
+		c, teardown := retryClient(1, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("Retry-After", retryAfter)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+
+		start := time.Now()
+		_, err := c.Tasks.Get(context.Background(), "some-task-id")
+		Expect(err).To(MatchError(habitica.ErrServer))
+		Expect(calls).To(Equal(2))
+		Expect(time.Since(start)).To(BeNumerically(">=", 250*time.Millisecond), retryAfter)
+		teardown()
+	}
+}
+
+func TestRetry_DoesNotRetryPost(t *testing.T) {
+	RegisterTestingT(t)
+	calls := 0
+	c, teardown := retryClient(3, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	defer teardown()
+
+	_, err := c.Tasks.Create(context.Background(), &habitica.Task{Text: "New Task"})
+	Expect(habitica.IsRateLimited(err)).To(BeTrue())
+	Expect(calls).To(Equal(1))
+}
+
+func TestRetry_RespectsContextDeadline(t *testing.T) {
+	RegisterTestingT(t)
+	calls := 0
+	c, teardown := retryClient(3, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	defer teardown()
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	start := time.Now()
+	_, err := c.Tasks.Get(timeoutCtx, "some-task-id")
+	Expect(habitica.IsRateLimited(err)).To(BeTrue())
+	Expect(calls).To(Equal(1))
+	Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+}
+
+func TestRateLimiter_FailsWhenResetIsPastDeadline(t *testing.T) {
+	RegisterTestingT(t)
+	calls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/tasks/some-task-id", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		reset := time.Now().Add(time.Minute).Unix()
+		w.Header().Set("X-RateLimit-Limit", "30")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset, 10))
+		w.WriteHeader(http.StatusOK)
+		w.Write(taskResponse)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	c, err := habitica.New("user", "api",
+		habitica.WithBaseURL(server.URL),
+		habitica.WithRateLimiter(),
+	)
+	Expect(err).ToNot(HaveOccurred())
+
+	_, err = c.Tasks.Get(context.Background(), "some-task-id")
+	Expect(err).ToNot(HaveOccurred())
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err = c.Tasks.Get(timeoutCtx, "some-task-id")
+	Expect(habitica.IsRateLimited(err)).To(BeTrue())
+	Expect(calls).To(Equal(1))
+}
+
+func retryClient(maxRetries int, handler http.HandlerFunc) (*habitica.HabiticaClient, func()) {
+	server := httptest.NewServer(handler)
+	c, err := habitica.New("user", "api",
+		habitica.WithBaseURL(server.URL),
+		habitica.WithRetry(maxRetries),
+	)
+	Expect(err).ToNot(HaveOccurred())
+	return c, server.Close
+}
@@ -0,0 +1,290 @@
+// Package recorder records HTTP interactions with the Habitica API to a
+// cassette file and replays them, so tests written against the live API can
+// run offline.
+//
+//	rec, err := recorder.New("testdata/tasks.json", recorder.Replay,
+//		recorder.WithScrub(userID, recorder.PlaceholderUserID))
+//	client, err := habitica.New(userID, apiToken, habitica.WithHttpClient(rec.Client()))
+//	defer rec.Stop()
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Mode is whether a Recorder records or replays.
+type Mode int
+
+const (
+	// Record sends requests to the real API and saves them on Stop.
+	Record Mode = iota
+	// Replay serves the saved responses in the order they were recorded
+	// without sending anything.
+	Replay
+)
+
+// PlaceholderUserID replaces the user id in cassettes. Replayed tests
+// authenticate with it so the requests match the recording.
+const PlaceholderUserID = "00000000-0000-4000-8000-000000000000"
+
+// Redacted replaces the values of credential headers in cassettes.
+const Redacted = "[REDACTED]"
+
+// redactedHeaders are never written to a cassette.
+var redactedHeaders = []string{"x-api-key", "Authorization", "Cookie", "Set-Cookie"}
+
+// ErrMismatch is returned in replay mode when a request does not match the
+// next recorded interaction, or when the cassette has run out.
+var ErrMismatch = errors.New("recorder: request does not match cassette")
+
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type Request struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+type Response struct {
+	StatusCode int         `json:"status"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Recorder is an http.RoundTripper that records or replays a cassette.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	replacer  *strings.Replacer
+	scrubs    []string
+
+	mu       sync.Mutex
+	cassette Cassette
+	next     int
+}
+
+type Option func(*Recorder)
+
+// WithTransport sets the transport used to reach the API when recording.
+// It defaults to http.DefaultTransport.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(r *Recorder) {
+		r.transport = rt
+	}
+}
+
+// WithScrub replaces every occurrence of secret in the recorded URLs,
+// headers and bodies with replacement. Requests are scrubbed the same way
+// before they are matched in replay mode.
+func WithScrub(secret, replacement string) Option {
+	return func(r *Recorder) {
+		if secret != "" {
+			r.scrubs = append(r.scrubs, secret, replacement)
+		}
+	}
+}
+
+// New returns a Recorder for the cassette at path. In replay mode the
+// cassette is loaded immediately and must exist.
+func New(path string, mode Mode, opts ...Option) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		mode:      mode,
+		transport: http.DefaultTransport,
+	}
+	for _, o := range opts {
+		o(r)
+	}
+	r.replacer = strings.NewReplacer(r.scrubs...)
+
+	if mode == Replay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read cassette: %s", err)
+		}
+		err = json.Unmarshal(data, &r.cassette)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode cassette %s: %s", path, err)
+		}
+	}
+	return r, nil
+}
+
+// Mode returns whether the recorder records or replays.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Client returns an *http.Client using the recorder, for
+// habitica.WithHttpClient.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	req, reqBody, err := requestBody(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read request body: %s", err)
+	}
+	recorded := Request{
+		Method:  req.Method,
+		URL:     r.scrub(req.URL.String()),
+		Headers: r.scrubHeaders(req.Header),
+		Body:    r.scrub(string(reqBody)),
+	}
+
+	if r.mode == Replay {
+		return r.replay(req, recorded)
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read response body: %s", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: recorded,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    r.scrubHeaders(resp.Header),
+			Body:       r.scrub(string(respBody)),
+		},
+	})
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.next >= len(r.cassette.Interactions) {
+		return nil, fmt.Errorf("%w: no interaction left for %s %s", ErrMismatch, recorded.Method, recorded.URL)
+	}
+	interaction := r.cassette.Interactions[r.next]
+	if interaction.Request.Method != recorded.Method || interaction.Request.URL != recorded.URL {
+		return nil, fmt.Errorf("%w: got %s %s, want %s %s", ErrMismatch,
+			recorded.Method, recorded.URL, interaction.Request.Method, interaction.Request.URL)
+	}
+	r.next++
+
+	resp := interaction.Response
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode)),
+		StatusCode:    resp.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        resp.Headers.Clone(),
+		Body:          io.NopCloser(strings.NewReader(resp.Body)),
+		ContentLength: int64(len(resp.Body)),
+		Request:       req,
+	}, nil
+}
+
+// Stop saves the cassette when recording. When replaying it reports an
+// error if some of the recorded interactions were never requested.
+func (r *Recorder) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.mode == Replay {
+		if left := len(r.cassette.Interactions) - r.next; left > 0 {
+			return fmt.Errorf("%w: %d interactions were not replayed", ErrMismatch, left)
+		}
+		return nil
+	}
+
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(r.path), 0o755)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append(data, '\n'), 0o644)
+}
+
+func (r *Recorder) scrub(s string) string {
+	return r.replacer.Replace(s)
+}
+
+func (r *Recorder) scrubHeaders(h http.Header) http.Header {
+	scrubbed := make(http.Header, len(h))
+	for k, values := range h {
+		for _, v := range values {
+			scrubbed.Add(k, r.scrub(v))
+		}
+	}
+	for _, k := range redactedHeaders {
+		if scrubbed.Get(k) != "" {
+			scrubbed.Set(k, Redacted)
+		}
+	}
+	return scrubbed
+}
+
+// requestBody returns the request body without consuming it. If the body
+// cannot be rewound the request is cloned with a copy of it.
+func requestBody(req *http.Request) (*http.Request, []byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, nil, err
+		}
+		defer body.Close()
+		data, err := io.ReadAll(body)
+		return req, data, err
+	}
+
+	data, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	return req, data, nil
+}
+
+// readBody reads the body and replaces it with a copy so it can still be
+// read by the caller.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	data, err := io.ReadAll(*body)
+	(*body).Close()
+	*body = io.NopCloser(bytes.NewReader(data))
+	return data, err
+}
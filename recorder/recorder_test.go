@@ -0,0 +1,110 @@
+package recorder_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/wfernandes/go-habitica/recorder"
+
+	. "github.com/onsi/gomega"
+)
+
+const userID = "b0413351-405f-416f-8787-947ec1c85199"
+
+func newRequest(method, url, body string) *http.Request {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	Expect(err).ToNot(HaveOccurred())
+	req.Header.Set("x-api-user", userID)
+	req.Header.Set("x-api-key", "secret-token")
+	return req
+}
+
+func send(c *http.Client, req *http.Request) (int, string) {
+	resp, err := c.Do(req)
+	Expect(err).ToNot(HaveOccurred())
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	Expect(err).ToNot(HaveOccurred())
+	return resp.StatusCode, string(body)
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	RegisterTestingT(t)
+
+	var received string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = string(body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=abc")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"success": true, "data": {"id": "task-id", "userId": "` + userID + `"}}`))
+	}))
+	defer ts.Close()
+
+	path := filepath.Join(t.TempDir(), "testdata", "cassette.json")
+	rec, err := recorder.New(path, recorder.Record, recorder.WithScrub(userID, recorder.PlaceholderUserID))
+	Expect(err).ToNot(HaveOccurred())
+	status, body := send(rec.Client(), newRequest(http.MethodPost, ts.URL+"/tasks/user", `{"text": "hi"}`))
+	Expect(status).To(Equal(http.StatusCreated))
+	Expect(received).To(Equal(`{"text": "hi"}`))
+	Expect(body).To(ContainSubstring(userID))
+	Expect(rec.Stop()).To(Succeed())
+
+	data, err := os.ReadFile(path)
+	Expect(err).ToNot(HaveOccurred())
+	cassette := string(data)
+	Expect(cassette).ToNot(ContainSubstring(userID))
+	Expect(cassette).ToNot(ContainSubstring("secret-token"))
+	Expect(cassette).ToNot(ContainSubstring("session=abc"))
+	Expect(cassette).To(ContainSubstring(recorder.PlaceholderUserID))
+	Expect(cassette).To(ContainSubstring(recorder.Redacted))
+
+	ts.Close()
+	rec, err = recorder.New(path, recorder.Replay)
+	Expect(err).ToNot(HaveOccurred())
+	req := newRequest(http.MethodPost, ts.URL+"/tasks/user", `{"text": "hi"}`)
+	req.Header.Set("x-api-user", recorder.PlaceholderUserID)
+	status, body = send(rec.Client(), req)
+	Expect(status).To(Equal(http.StatusCreated))
+	Expect(body).To(ContainSubstring(`"userId": "` + recorder.PlaceholderUserID + `"`))
+	Expect(rec.Stop()).To(Succeed())
+}
+
+func TestReplay_Mismatch(t *testing.T) {
+	RegisterTestingT(t)
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	err := os.WriteFile(path, []byte(`{"interactions": [
+		{"request": {"method": "GET", "url": "https://habitica.com/api/v3/tasks/user"}, "response": {"status": 200, "body": "{}"}},
+		{"request": {"method": "GET", "url": "https://habitica.com/api/v3/tags"}, "response": {"status": 200, "body": "{}"}}
+	]}`), 0o644)
+	Expect(err).ToNot(HaveOccurred())
+
+	rec, err := recorder.New(path, recorder.Replay)
+	Expect(err).ToNot(HaveOccurred())
+	_, err = rec.Client().Do(newRequest(http.MethodGet, "https://habitica.com/api/v3/user", ""))
+	Expect(errors.Is(err, recorder.ErrMismatch)).To(BeTrue())
+
+	status, _ := send(rec.Client(), newRequest(http.MethodGet, "https://habitica.com/api/v3/tasks/user", ""))
+	Expect(status).To(Equal(http.StatusOK))
+	Expect(errors.Is(rec.Stop(), recorder.ErrMismatch)).To(BeTrue())
+
+	send(rec.Client(), newRequest(http.MethodGet, "https://habitica.com/api/v3/tags", ""))
+	_, err = rec.Client().Do(newRequest(http.MethodGet, "https://habitica.com/api/v3/tags", ""))
+	Expect(errors.Is(err, recorder.ErrMismatch)).To(BeTrue())
+	Expect(rec.Stop()).To(Succeed())
+}
+
+func TestReplay_MissingCassette(t *testing.T) {
+	RegisterTestingT(t)
+
+	_, err := recorder.New(filepath.Join(t.TempDir(), "missing.json"), recorder.Replay)
+	Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,88 @@
+package habitica
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ScoreDirection is whether a task is scored positively or negatively.
+type ScoreDirection string
+
+const (
+	Up   ScoreDirection = "up"
+	Down ScoreDirection = "down"
+)
+
+// ScoreResult holds the user's stats after scoring a task and how much the
+// task's value changed.
+type ScoreResult struct {
+	Delta float64  `json:"delta"`
+	HP    float64  `json:"hp"`
+	MP    float64  `json:"mp"`
+	Exp   float64  `json:"exp"`
+	GP    float64  `json:"gp"`
+	Lvl   int      `json:"lvl"`
+	Class string   `json:"class"`
+	Tmp   ScoreTmp `json:"_tmp"`
+}
+
+// ScoreTmp holds the side effects of scoring a task, such as item drops,
+// quest progress and critical hits.
+type ScoreTmp struct {
+	Crit        float64         `json:"crit,omitempty"`
+	StreakBonus float64         `json:"streakBonus,omitempty"`
+	Drop        *Drop           `json:"drop,omitempty"`
+	Quest       *QuestDelta     `json:"quest,omitempty"`
+	LeveledUp   json.RawMessage `json:"leveledUp,omitempty"`
+}
+
+// LevelUp reports whether scoring the task made the user gain a level.
+func (t ScoreTmp) LevelUp() bool {
+	switch string(t.LeveledUp) {
+	case "", "null", "false":
+		return false
+	}
+	return true
+}
+
+// Drop is an item the user received for scoring a task.
+type Drop struct {
+	Type   string  `json:"type"`
+	Key    string  `json:"key"`
+	Value  float64 `json:"value,omitempty"`
+	Dialog string  `json:"dialog,omitempty"`
+}
+
+// QuestDelta is the progress a scored task made on the active quest.
+type QuestDelta struct {
+	ProgressDelta float64 `json:"progressDelta,omitempty"`
+	Collection    int     `json:"collection,omitempty"`
+}
+
+type ScoreResponse = Envelope[*ScoreResult]
+
+// Score scores a task up or down. The level up notifications are returned
+// in the Notifications of the response.
+func (t *TaskService) Score(ctx context.Context, id string, direction ScoreDirection) (*ScoreResponse, error) {
+	if direction != Up && direction != Down {
+		return nil, fmt.Errorf("invalid score direction: %q", direction)
+	}
+	req, err := t.client.NewRequest(http.MethodPost, fmt.Sprintf("tasks/%s/score/%s", id, direction), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*ScoreResult](ctx, t.client, req)
+}
+
+// ScoreChecklistItem toggles the completion of a checklist item.
+func (t *TaskService) ScoreChecklistItem(ctx context.Context, taskID, itemID string) (*TaskResponse, error) {
+	req, err := t.client.NewRequest(http.MethodPost, fmt.Sprintf("tasks/%s/checklist/%s/score", taskID, itemID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*Task](ctx, t.client, req)
+}
@@ -0,0 +1,125 @@
+package habitica_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/wfernandes/go-habitica"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestScore_Up(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/tasks/some-task-id/score/up", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(scoreResponse)
+	})
+	resp, err := client.Tasks.Score(ctx, "some-task-id", habitica.Up)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+
+	result := resp.Data
+	Expect(result.Delta).To(BeNumerically("~", 0.97, 0.01))
+	Expect(result.HP).To(Equal(50.0))
+	Expect(result.MP).To(BeNumerically("~", 34.1, 0.01))
+	Expect(result.Exp).To(Equal(26.0))
+	Expect(result.GP).To(BeNumerically("~", 27.9, 0.01))
+	Expect(result.Lvl).To(Equal(5))
+	Expect(result.Class).To(Equal("warrior"))
+	Expect(result.Tmp.Crit).To(Equal(1.5))
+	Expect(result.Tmp.Drop.Type).To(Equal("Food"))
+	Expect(result.Tmp.Drop.Key).To(Equal("Meat"))
+	Expect(result.Tmp.Quest.ProgressDelta).To(Equal(1.5))
+	Expect(result.Tmp.LevelUp()).To(BeTrue())
+	Expect(resp.Notifications).To(HaveLen(1))
+}
+
+func TestScore_Down(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/tasks/some-task-id/score/down", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": {"delta": -1, "hp": 48, "_tmp": {}}}`))
+	})
+	resp, err := client.Tasks.Score(ctx, "some-task-id", habitica.Down)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+	Expect(resp.Data.Delta).To(Equal(-1.0))
+	Expect(resp.Data.Tmp.Drop).To(BeNil())
+	Expect(resp.Data.Tmp.LevelUp()).To(BeFalse())
+}
+
+func TestScore_InvalidDirection(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	_, err := client.Tasks.Score(ctx, "some-task-id", "sideways")
+	Expect(err).To(HaveOccurred())
+}
+
+func TestScore_ChecklistItem(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/tasks/some-task-id/checklist/some-item-id/score", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(taskResponse)
+	})
+	resp, err := client.Tasks.ScoreChecklistItem(ctx, "some-task-id", "some-item-id")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+	Expect(resp.Data.Checklist).To(HaveLen(1))
+}
+
+var scoreResponse = []byte(`
+{
+    "success": true,
+    "data": {
+        "delta": 0.9746999906450404,
+        "_tmp": {
+            "crit": 1.5,
+            "quest": {
+                "progressDelta": 1.5
+            },
+            "drop": {
+                "type": "Food",
+                "key": "Meat",
+                "value": 1,
+                "dialog": "You've found Meat! Feed this to a pet and it may grow into a sturdy steed."
+            },
+            "leveledUp": true
+        },
+        "hp": 50,
+        "mp": 34.1,
+        "exp": 26,
+        "gp": 27.9,
+        "lvl": 5,
+        "class": "warrior",
+        "points": 0,
+        "str": 0,
+        "con": 0,
+        "int": 0,
+        "per": 0
+    },
+    "notifications": [
+        {
+            "type": "LEVELED_UP",
+            "data": {},
+            "id": "c9a6a5b5-9dd1-4bf4-9a6f-3d1e5d9c4e1f"
+        }
+    ]
+}`)
@@ -0,0 +1,174 @@
+package habitica
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ShopType is one of the shops listed by ShopService.Get.
+type ShopType string
+
+const (
+	ShopMarket        ShopType = "market"
+	ShopQuests        ShopType = "quests"
+	ShopSeasonal      ShopType = "seasonal"
+	ShopTimeTravelers ShopType = "time-travelers"
+)
+
+type Shop struct {
+	Identifier string         `json:"identifier"`
+	Text       string         `json:"text"`
+	Notes      string         `json:"notes,omitempty"`
+	ImageName  string         `json:"imageName,omitempty"`
+	Categories []ShopCategory `json:"categories"`
+}
+
+type ShopCategory struct {
+	Identifier string     `json:"identifier"`
+	Text       string     `json:"text"`
+	Items      []ShopItem `json:"items"`
+}
+
+type ShopItem struct {
+	Key          string  `json:"key"`
+	Text         string  `json:"text"`
+	Notes        string  `json:"notes,omitempty"`
+	Type         string  `json:"type,omitempty"`
+	PurchaseType string  `json:"purchaseType,omitempty"`
+	Value        float64 `json:"value"`
+	Currency     string  `json:"currency"`
+	Locked       bool    `json:"locked,omitempty"`
+	Class        string  `json:"class,omitempty"`
+}
+
+// PurchaseResult holds the parts of the user that changed with a purchase.
+// Depending on the endpoint only some of the fields are set.
+type PurchaseResult struct {
+	Stats   *Stats
+	Items   *Items
+	Flags   *Flags
+	Armoire *ArmoireDrop
+
+	// Balance is the user's gem balance, in units of 4 gems.
+	Balance *float64
+}
+
+// ArmoireDrop is what the Enchanted Armoire gave.
+type ArmoireDrop struct {
+	Type     string  `json:"type"`
+	DropKey  string  `json:"dropKey"`
+	DropText string  `json:"dropText"`
+	Value    float64 `json:"value,omitempty"`
+}
+
+// UnmarshalJSON handles the shapes purchases return: either an object
+// holding stats, items and flags, or the user's stats or items on their own.
+func (p *PurchaseResult) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+	*p = PurchaseResult{}
+
+	targets := map[string]interface{}{
+		"stats":   &p.Stats,
+		"items":   &p.Items,
+		"flags":   &p.Flags,
+		"armoire": &p.Armoire,
+		"balance": &p.Balance,
+	}
+	nested := false
+	for key, target := range targets {
+		raw, ok := fields[key]
+		if !ok {
+			continue
+		}
+		nested = true
+		err = json.Unmarshal(raw, target)
+		if err != nil {
+			return fmt.Errorf("unable to decode %s: %s", key, err)
+		}
+	}
+	if nested {
+		return nil
+	}
+
+	if _, ok := fields["gp"]; ok {
+		return json.Unmarshal(data, &p.Stats)
+	}
+	if _, ok := fields["gear"]; ok {
+		return json.Unmarshal(data, &p.Items)
+	}
+	return nil
+}
+
+// Gems returns the user's gem count after the purchase, if it was returned.
+func (p *PurchaseResult) Gems() (int, bool) {
+	if p.Balance == nil {
+		return 0, false
+	}
+	return int(*p.Balance * 4), true
+}
+
+type PurchaseResponse = Envelope[*PurchaseResult]
+
+type ShopResponse = Envelope[*Shop]
+
+type ShopService struct {
+	client *HabiticaClient
+}
+
+func newShopService(h *HabiticaClient) *ShopService {
+	return &ShopService{
+		client: h,
+	}
+}
+
+// Get lists the items for sale in a shop.
+func (s *ShopService) Get(ctx context.Context, shop ShopType) (*ShopResponse, error) {
+	req, err := s.client.NewRequest(http.MethodGet, fmt.Sprintf("shops/%s", shop), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*Shop](ctx, s.client, req)
+}
+
+// Buy buys an item with gold, such as gear or a health potion. A failed
+// purchase for lack of gold matches ErrNotEnoughGold.
+func (s *ShopService) Buy(ctx context.Context, key string) (*PurchaseResponse, error) {
+	return s.purchase(ctx, fmt.Sprintf("user/buy/%s", key))
+}
+
+// Purchase buys an item with gems. itemType is e.g. "eggs", "hatchingPotions",
+// "food", "quests" or "gear".
+func (s *ShopService) Purchase(ctx context.Context, itemType, key string) (*PurchaseResponse, error) {
+	return s.purchase(ctx, fmt.Sprintf("user/purchase/%s/%s", itemType, key))
+}
+
+func (s *ShopService) BuyHealthPotion(ctx context.Context) (*PurchaseResponse, error) {
+	return s.purchase(ctx, "user/buy-health-potion")
+}
+
+// BuyArmoire buys a random item from the Enchanted Armoire. The result's
+// Armoire field holds what was dropped.
+func (s *ShopService) BuyArmoire(ctx context.Context) (*PurchaseResponse, error) {
+	return s.purchase(ctx, "user/buy-armoire")
+}
+
+// PurchaseHourglass buys a time traveler item with mystic hourglasses.
+func (s *ShopService) PurchaseHourglass(ctx context.Context, itemType, key string) (*PurchaseResponse, error) {
+	return s.purchase(ctx, fmt.Sprintf("user/purchase-hourglass/%s/%s", itemType, key))
+}
+
+func (s *ShopService) purchase(ctx context.Context, urlPath string) (*PurchaseResponse, error) {
+	req, err := s.client.NewRequest(http.MethodPost, urlPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*PurchaseResult](ctx, s.client, req)
+}
@@ -0,0 +1,212 @@
+package habitica_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/wfernandes/go-habitica"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestGet_Shop(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/shops/market", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(shopResponse)
+	})
+	resp, err := client.Shops.Get(ctx, habitica.ShopMarket)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodGet))
+
+	shop := resp.Data
+	Expect(shop.Identifier).To(Equal("market"))
+	Expect(shop.Categories).To(HaveLen(1))
+	Expect(shop.Categories[0].Identifier).To(Equal("eggs"))
+	Expect(shop.Categories[0].Items[0].Key).To(Equal("Wolf"))
+	Expect(shop.Categories[0].Items[0].Value).To(Equal(3.0))
+	Expect(shop.Categories[0].Items[0].Currency).To(Equal("gems"))
+}
+
+func TestBuy_Gear(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/user/buy/weapon_warrior_1", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": {"items": {"gear": {"owned": {"weapon_warrior_1": true}}}, "stats": {"gp": 65.2}, "flags": {"armoireEnabled": true}}, "message": "Bought Training Sword."}`))
+	})
+	resp, err := client.Shops.Buy(ctx, "weapon_warrior_1")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+	Expect(resp.Data.Stats.GP).To(Equal(65.2))
+	Expect(resp.Data.Items.Gear.Owned).To(HaveKeyWithValue("weapon_warrior_1", true))
+	Expect(resp.Data.Flags.ArmoireEnabled).To(BeTrue())
+	Expect(resp.Message).To(Equal("Bought Training Sword."))
+}
+
+func TestBuy_NotEnoughGold(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/buy/weapon_warrior_1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"success": false, "error": "NotAuthorized", "message": "Not Enough Gold"}`))
+	})
+	_, err := client.Shops.Buy(ctx, "weapon_warrior_1")
+	Expect(errors.Is(err, habitica.ErrNotEnoughGold)).To(BeTrue())
+	Expect(errors.Is(err, habitica.ErrUnauthorized)).To(BeTrue())
+	Expect(errors.Is(err, habitica.ErrNotEnoughGems)).To(BeFalse())
+}
+
+func TestPurchase_WithGems(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/user/purchase/eggs/Wolf", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": {"items": {"eggs": {"Wolf": 3}}, "balance": 1.75}, "message": "Purchased Wolf egg."}`))
+	})
+	resp, err := client.Shops.Purchase(ctx, "eggs", "Wolf")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+	Expect(resp.Data.Items.Eggs).To(HaveKeyWithValue("Wolf", 3))
+	gems, ok := resp.Data.Gems()
+	Expect(ok).To(BeTrue())
+	Expect(gems).To(Equal(7))
+}
+
+func TestPurchase_NotEnoughGems(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/purchase/eggs/Wolf", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"success": false, "error": "NotAuthorized", "message": "Not enough Gems"}`))
+	})
+	_, err := client.Shops.Purchase(ctx, "eggs", "Wolf")
+	Expect(errors.Is(err, habitica.ErrNotEnoughGems)).To(BeTrue())
+	Expect(errors.Is(err, habitica.ErrNotEnoughGold)).To(BeFalse())
+}
+
+func TestBuyHealthPotion(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/buy-health-potion", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": {"hp": 50, "gp": 60.2, "lvl": 12}, "message": "Bought Health Potion."}`))
+	})
+	resp, err := client.Shops.BuyHealthPotion(ctx)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.Data.Stats.HP).To(Equal(50.0))
+	Expect(resp.Data.Stats.GP).To(Equal(60.2))
+	Expect(resp.Data.Items).To(BeNil())
+	_, ok := resp.Data.Gems()
+	Expect(ok).To(BeFalse())
+}
+
+func TestBuyArmoire(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/buy-armoire", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": {"items": {"food": {"Meat": 4}}, "flags": {"armoireEmpty": false}, "armoire": {"type": "food", "dropKey": "Meat", "dropText": "Meat"}}}`))
+	})
+	resp, err := client.Shops.BuyArmoire(ctx)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(resp.Data.Armoire.Type).To(Equal("food"))
+	Expect(resp.Data.Armoire.DropKey).To(Equal("Meat"))
+	Expect(resp.Data.Items.Food).To(HaveKeyWithValue("Meat", 4))
+}
+
+func TestPurchaseHourglass(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/user/purchase-hourglass/pets/MantisShrimp-Base", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": {"items": {"pets": {"MantisShrimp-Base": 5}}}}`))
+	})
+	resp, err := client.Shops.PurchaseHourglass(ctx, "pets", "MantisShrimp-Base")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+	Expect(resp.Data.Items.Pets).To(HaveKeyWithValue("MantisShrimp-Base", 5))
+}
+
+func TestPurchaseHourglass_NotEnoughHourglasses(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/purchase-hourglass/pets/MantisShrimp-Base", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"success": false, "error": "NotAuthorized", "message": "You don't have enough Mystic Hourglasses."}`))
+	})
+	_, err := client.Shops.PurchaseHourglass(ctx, "pets", "MantisShrimp-Base")
+	Expect(errors.Is(err, habitica.ErrNotEnoughHourglasses)).To(BeTrue())
+	Expect(errors.Is(err, habitica.ErrNotEnoughGems)).To(BeFalse())
+}
+
+func TestBuy_NotEnoughGoldTranslated(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/buy/weapon_warrior_1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"success": false, "error": "NotAuthorized", "message": "Nicht genug Gold"}`))
+	})
+	_, err := client.Shops.Buy(ctx, "weapon_warrior_1")
+	Expect(errors.Is(err, habitica.ErrNotEnoughGold)).To(BeFalse())
+	Expect(errors.Is(err, habitica.ErrUnauthorized)).To(BeTrue())
+}
+
+var shopResponse = []byte(`
+{
+    "success": true,
+    "data": {
+        "identifier": "market",
+        "text": "Market",
+        "notes": "Buy and sell eggs, potions and food.",
+        "imageName": "npc_alex",
+        "categories": [
+            {
+                "identifier": "eggs",
+                "text": "Eggs",
+                "items": [
+                    {
+                        "key": "Wolf",
+                        "text": "Wolf",
+                        "notes": "Find a hatching potion to pour on this egg.",
+                        "purchaseType": "eggs",
+                        "value": 3,
+                        "currency": "gems",
+                        "locked": false
+                    }
+                ]
+            }
+        ]
+    },
+    "notifications": []
+}`)
@@ -0,0 +1,142 @@
+package habitica
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Skill is the key of a class skill or transformation item.
+type Skill string
+
+const (
+	// Mage
+	SkillFireball      Skill = "fireball"
+	SkillEtherealSurge Skill = "mpheal"
+	SkillEarthquake    Skill = "earth"
+	SkillChillingFrost Skill = "frost"
+
+	// Warrior
+	SkillBrutalSmash      Skill = "smash"
+	SkillDefensiveStance  Skill = "defensiveStance"
+	SkillValorousPresence Skill = "valorousPresence"
+	SkillIntimidatingGaze Skill = "intimidate"
+
+	// Rogue
+	SkillPickPocket   Skill = "pickPocket"
+	SkillBackstab     Skill = "backStab"
+	SkillToolsOfTrade Skill = "toolsOfTrade"
+	SkillStealth      Skill = "stealth"
+
+	// Healer
+	SkillHeal              Skill = "heal"
+	SkillSearingBrightness Skill = "brightness"
+	SkillProtectiveAura    Skill = "protectAura"
+	SkillBlessing          Skill = "healAll"
+
+	// Transformation items
+	SkillSnowball       Skill = "snowball"
+	SkillSpookySparkles Skill = "spookySparkles"
+	SkillSeafoam        Skill = "seafoam"
+	SkillShinySeed      Skill = "shinySeed"
+)
+
+// TargetType is what a skill is cast on.
+type TargetType string
+
+const (
+	TargetSelf  TargetType = "self"
+	TargetUser  TargetType = "user"
+	TargetParty TargetType = "party"
+	TargetTask  TargetType = "task"
+)
+
+// ErrInvalidTarget is returned by SkillService.Cast when the target does not
+// match the skill's target type.
+var ErrInvalidTarget = errors.New("invalid skill target")
+
+var skillTargets = map[Skill]TargetType{
+	SkillFireball:      TargetTask,
+	SkillEtherealSurge: TargetParty,
+	SkillEarthquake:    TargetParty,
+	SkillChillingFrost: TargetSelf,
+
+	SkillBrutalSmash:      TargetTask,
+	SkillDefensiveStance:  TargetSelf,
+	SkillValorousPresence: TargetParty,
+	SkillIntimidatingGaze: TargetParty,
+
+	SkillPickPocket:   TargetTask,
+	SkillBackstab:     TargetTask,
+	SkillToolsOfTrade: TargetParty,
+	SkillStealth:      TargetSelf,
+
+	SkillHeal:              TargetSelf,
+	SkillSearingBrightness: TargetSelf,
+	SkillProtectiveAura:    TargetParty,
+	SkillBlessing:          TargetParty,
+
+	SkillSnowball:       TargetUser,
+	SkillSpookySparkles: TargetUser,
+	SkillSeafoam:        TargetUser,
+	SkillShinySeed:      TargetUser,
+}
+
+// Target returns what the skill is cast on, or an empty TargetType if the
+// skill is unknown.
+func (s Skill) Target() TargetType {
+	return skillTargets[s]
+}
+
+// CastResult is the state after casting a skill. Task is set for skills
+// cast on a task and PartyMembers for skills cast on the party.
+type CastResult struct {
+	User         *User  `json:"user"`
+	Task         *Task  `json:"task,omitempty"`
+	PartyMembers []User `json:"partyMembers,omitempty"`
+}
+
+type CastResponse = Envelope[*CastResult]
+
+type castOptions struct {
+	TargetID string `url:"targetId,omitempty"`
+}
+
+type SkillService struct {
+	client *HabiticaClient
+}
+
+func newSkillService(h *HabiticaClient) *SkillService {
+	return &SkillService{
+		client: h,
+	}
+}
+
+// Cast casts a skill. targetID is the id of the task or user for skills
+// targeting one, and must be empty for self and party skills.
+func (s *SkillService) Cast(ctx context.Context, skill Skill, targetID string) (*CastResponse, error) {
+	switch skill.Target() {
+	case "":
+		return nil, fmt.Errorf("unknown skill %q", skill)
+	case TargetTask, TargetUser:
+		if targetID == "" {
+			return nil, fmt.Errorf("%w: %s needs a %s id", ErrInvalidTarget, skill, skill.Target())
+		}
+	default:
+		if targetID != "" {
+			return nil, fmt.Errorf("%w: %s targets %s and takes no id", ErrInvalidTarget, skill, skill.Target())
+		}
+	}
+
+	urlPath, err := addOptions(fmt.Sprintf("user/class/cast/%s", skill), &castOptions{TargetID: targetID})
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode options: %s", err)
+	}
+	req, err := s.client.NewRequest(http.MethodPost, urlPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*CastResult](ctx, s.client, req)
+}
@@ -0,0 +1,110 @@
+package habitica_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/wfernandes/go-habitica"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCast_TaskSkill(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/user/class/cast/fireball", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(castResponse)
+	})
+	resp, err := client.Skills.Cast(ctx, habitica.SkillFireball, "some-task-id")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+	Expect(request.URL.Query().Get("targetId")).To(Equal("some-task-id"))
+	Expect(resp.Data.User.Stats.MP).To(Equal(20.0))
+	Expect(resp.Data.User.Stats.Exp).To(Equal(135.0))
+	Expect(resp.Data.Task.Value).To(Equal(2.5))
+}
+
+func TestCast_PartySkill(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/user/class/cast/toolsOfTrade", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": {"user": {"stats": {"mp": 5}}, "partyMembers": [{"id": "member-a"}, {"id": "member-b"}]}}`))
+	})
+	resp, err := client.Skills.Cast(ctx, habitica.SkillToolsOfTrade, "")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.URL.RawQuery).To(BeEmpty())
+	Expect(resp.Data.User.Stats.MP).To(Equal(5.0))
+	Expect(resp.Data.PartyMembers).To(HaveLen(2))
+}
+
+func TestCast_ValidatesTarget(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	called := false
+	mux.HandleFunc("/user/class/cast/", func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+
+	_, err := client.Skills.Cast(ctx, habitica.SkillBrutalSmash, "")
+	Expect(errors.Is(err, habitica.ErrInvalidTarget)).To(BeTrue())
+
+	_, err = client.Skills.Cast(ctx, habitica.SkillSnowball, "")
+	Expect(errors.Is(err, habitica.ErrInvalidTarget)).To(BeTrue())
+
+	_, err = client.Skills.Cast(ctx, habitica.SkillHeal, "some-task-id")
+	Expect(errors.Is(err, habitica.ErrInvalidTarget)).To(BeTrue())
+
+	_, err = client.Skills.Cast(ctx, habitica.SkillBlessing, "some-user-id")
+	Expect(errors.Is(err, habitica.ErrInvalidTarget)).To(BeTrue())
+
+	_, err = client.Skills.Cast(ctx, "summonDragon", "")
+	Expect(err).To(HaveOccurred())
+
+	Expect(called).To(BeFalse())
+}
+
+func TestSkill_Target(t *testing.T) {
+	RegisterTestingT(t)
+	Expect(habitica.SkillFireball.Target()).To(Equal(habitica.TargetTask))
+	Expect(habitica.SkillEarthquake.Target()).To(Equal(habitica.TargetParty))
+	Expect(habitica.SkillStealth.Target()).To(Equal(habitica.TargetSelf))
+	Expect(habitica.SkillSeafoam.Target()).To(Equal(habitica.TargetUser))
+	Expect(habitica.Skill("unknown").Target()).To(BeEmpty())
+}
+
+var castResponse = []byte(`
+{
+    "success": true,
+    "data": {
+        "user": {
+            "stats": {
+                "hp": 50,
+                "mp": 20,
+                "exp": 135,
+                "gp": 85.2,
+                "lvl": 12,
+                "class": "wizard"
+            }
+        },
+        "task": {
+            "id": "some-task-id",
+            "text": "Write report",
+            "type": "todo",
+            "value": 2.5
+        }
+    },
+    "notifications": []
+}`)
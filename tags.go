@@ -2,30 +2,23 @@ package habitica
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 )
 
 type Tag struct {
-	ID   string `json: id`
-	Name string `json: name`
+	ID   string `json:"id"`
+	Name string `json:"name"`
 }
 
 type ReorderTag struct {
-	TagID string `json: tagId`
-	To    int    `json: to`
+	TagID string `json:"tagId"`
+	To    int    `json:"to"`
 }
 
-type TagResponse struct {
-	Success bool `json:"success"`
-	Data    *Tag `json:"data,omitempty"`
-}
+type TagResponse = Envelope[*Tag]
 
-type TagsResponse struct {
-	Success bool  `json:"success"`
-	Data    []Tag `json:"data,omitempty"`
-}
+type TagsResponse = Envelope[[]Tag]
 
 type TagService struct {
 	client *HabiticaClient
@@ -43,7 +36,7 @@ func (s *TagService) Create(ctx context.Context, tag *Tag) (*TagResponse, error)
 		return nil, fmt.Errorf("unable to create request: %s", err)
 	}
 
-	return s.getTagResponse(ctx, req)
+	return do[*Tag](ctx, s.client, req)
 }
 
 func (s *TagService) Delete(ctx context.Context, id string) (*TagResponse, error) {
@@ -52,7 +45,7 @@ func (s *TagService) Delete(ctx context.Context, id string) (*TagResponse, error
 		return nil, fmt.Errorf("unable to create request: %s", err)
 	}
 
-	return s.getTagResponse(ctx, req)
+	return do[*Tag](ctx, s.client, req)
 }
 
 func (s *TagService) Get(ctx context.Context, id string) (*TagResponse, error) {
@@ -61,7 +54,7 @@ func (s *TagService) Get(ctx context.Context, id string) (*TagResponse, error) {
 		return nil, fmt.Errorf("unable to create request: %s", err)
 	}
 
-	return s.getTagResponse(ctx, req)
+	return do[*Tag](ctx, s.client, req)
 }
 
 func (s *TagService) List(ctx context.Context) (*TagsResponse, error) {
@@ -70,18 +63,7 @@ func (s *TagService) List(ctx context.Context) (*TagsResponse, error) {
 		return nil, fmt.Errorf("unable to create request: %s", err)
 	}
 
-	resp, err := s.client.Do(ctx, req)
-	if err != nil {
-		return nil, fmt.Errorf("unable to perform request: %s", err)
-	}
-	defer resp.Body.Close()
-
-	var tagsResp TagsResponse
-	err = json.NewDecoder(resp.Body).Decode(&tagsResp)
-	if err != nil {
-		return nil, fmt.Errorf("unable to decode response body: %s", err)
-	}
-	return &tagsResp, err
+	return do[[]Tag](ctx, s.client, req)
 }
 
 func (s *TagService) Reorder(ctx context.Context, t *ReorderTag) (*TagResponse, error) {
@@ -90,7 +72,7 @@ func (s *TagService) Reorder(ctx context.Context, t *ReorderTag) (*TagResponse,
 		return nil, fmt.Errorf("unable to create request: %s", err)
 	}
 
-	return s.getTagResponse(ctx, req)
+	return do[*Tag](ctx, s.client, req)
 }
 
 func (s *TagService) Update(ctx context.Context, id string, t *Tag) (*TagResponse, error) {
@@ -99,20 +81,5 @@ func (s *TagService) Update(ctx context.Context, id string, t *Tag) (*TagRespons
 		return nil, fmt.Errorf("unable to create request: %s", err)
 	}
 
-	return s.getTagResponse(ctx, req)
-}
-
-func (s *TagService) getTagResponse(ctx context.Context, req *http.Request) (*TagResponse, error) {
-	resp, err := s.client.Do(ctx, req)
-	if err != nil {
-		return nil, fmt.Errorf("unable to perform request: %s", err)
-	}
-	defer resp.Body.Close()
-
-	var tagResp TagResponse
-	err = json.NewDecoder(resp.Body).Decode(&tagResp)
-	if err != nil {
-		return nil, fmt.Errorf("unable to decode response body: %s", err)
-	}
-	return &tagResp, err
+	return do[*Tag](ctx, s.client, req)
 }
@@ -5,36 +5,211 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 )
 
+type TaskType string
+
+const (
+	TaskTypeHabit  TaskType = "habit"
+	TaskTypeDaily  TaskType = "daily"
+	TaskTypeTodo   TaskType = "todo"
+	TaskTypeReward TaskType = "reward"
+)
+
+// Priority is the difficulty of a task, which scales its rewards.
+type Priority float64
+
+const (
+	PriorityTrivial Priority = 0.1
+	PriorityEasy    Priority = 1
+	PriorityMedium  Priority = 1.5
+	PriorityHard    Priority = 2
+)
+
+// Attribute is the stat that scoring a task trains.
+type Attribute string
+
+const (
+	AttributeStrength     Attribute = "str"
+	AttributeIntelligence Attribute = "int"
+	AttributeConstitution Attribute = "con"
+	AttributePerception   Attribute = "per"
+)
+
+// Frequency is how often a daily repeats.
+type Frequency string
+
+const (
+	FrequencyDaily   Frequency = "daily"
+	FrequencyWeekly  Frequency = "weekly"
+	FrequencyMonthly Frequency = "monthly"
+	FrequencyYearly  Frequency = "yearly"
+)
+
+// Task is a habit, daily, todo or reward. Fields that only apply to some
+// task types are left empty for the others and omitted when sending, so a
+// task that was fetched can be passed to TaskService.Update unchanged.
 type Task struct {
 	ID        string          `json:"id"`
 	UserID    string          `json:"userId"`
 	Text      string          `json:"text"`
-	Type      string          `json:"type"`
+	Type      TaskType        `json:"type"`
 	Notes     string          `json:"notes"`
 	Tags      []string        `json:"tags"`
 	Completed bool            `json:"completed"`
 	Checklist []ChecklistItem `json:"checklist"`
+
+	Alias     string         `json:"alias,omitempty"`
+	Priority  Priority       `json:"priority,omitempty"`
+	Value     float64        `json:"value,omitempty"`
+	Attribute Attribute      `json:"attribute,omitempty"`
+	Reminders []Reminder     `json:"reminders,omitempty"`
+	History   []TaskHistory  `json:"history,omitempty"`
+	Group     *TaskGroup     `json:"group,omitempty"`
+	Challenge *TaskChallenge `json:"challenge,omitempty"`
+	CreatedAt time.Time      `json:"createdAt,omitzero"`
+	UpdatedAt time.Time      `json:"updatedAt,omitzero"`
+
+	// RequiresApproval is only sent when creating or updating a group task.
+	// The current state is in Group.Approval.Required.
+	RequiresApproval *bool `json:"requiresApproval,omitempty"`
+
+	// Habits
+	Up          *bool `json:"up,omitempty"`
+	Down        *bool `json:"down,omitempty"`
+	CounterUp   int   `json:"counterUp,omitempty"`
+	CounterDown int   `json:"counterDown,omitempty"`
+
+	// Dailies
+	Frequency         Frequency   `json:"frequency,omitempty"`
+	Repeat            *Repeat     `json:"repeat,omitempty"`
+	EveryX            int         `json:"everyX,omitempty"`
+	StartDate         time.Time   `json:"startDate,omitzero"`
+	DaysOfMonth       []int       `json:"daysOfMonth,omitempty"`
+	WeeksOfMonth      []int       `json:"weeksOfMonth,omitempty"`
+	Streak            int         `json:"streak,omitempty"`
+	IsDue             bool        `json:"isDue,omitempty"`
+	NextDue           []time.Time `json:"nextDue,omitempty"`
+	YesterDaily       *bool       `json:"yesterDaily,omitempty"`
+	CollapseChecklist bool        `json:"collapseChecklist,omitempty"`
+
+	// Todos
+	Date          time.Time `json:"date,omitzero"`
+	DateCompleted time.Time `json:"dateCompleted,omitzero"`
+}
+
+// Repeat holds the days of the week a weekly daily is due.
+type Repeat struct {
+	Monday    bool `json:"m"`
+	Tuesday   bool `json:"t"`
+	Wednesday bool `json:"w"`
+	Thursday  bool `json:"th"`
+	Friday    bool `json:"f"`
+	Saturday  bool `json:"s"`
+	Sunday    bool `json:"su"`
 }
 
-type TaskResponse struct {
-	Success bool   `json:"success"`
-	Data    *Task  `json:"data,omitempty"`
-	Error   string `json:"error,omitempty"`
-	Message string `json:"message,omitempty"`
+type Reminder struct {
+	ID        string    `json:"id,omitempty"`
+	StartDate time.Time `json:"startDate,omitzero"`
+	Time      time.Time `json:"time"`
+}
+
+// TaskHistory is a single entry in the value history of a habit or daily.
+type TaskHistory struct {
+	Date       time.Time
+	Value      float64
+	ScoredUp   int
+	ScoredDown int
+	IsDue      bool
+	Completed  bool
+}
+
+type taskHistoryJSON struct {
+	Date       json.RawMessage `json:"date"`
+	Value      float64         `json:"value"`
+	ScoredUp   int             `json:"scoredUp,omitempty"`
+	ScoredDown int             `json:"scoredDown,omitempty"`
+	IsDue      bool            `json:"isDue,omitempty"`
+	Completed  bool            `json:"completed,omitempty"`
+}
+
+// UnmarshalJSON decodes the history date which the API sends as milliseconds
+// since the epoch.
+func (h *TaskHistory) UnmarshalJSON(data []byte) error {
+	var raw taskHistoryJSON
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	*h = TaskHistory{
+		Value:      raw.Value,
+		ScoredUp:   raw.ScoredUp,
+		ScoredDown: raw.ScoredDown,
+		IsDue:      raw.IsDue,
+		Completed:  raw.Completed,
+	}
+	return unmarshalTimestamp(raw.Date, &h.Date)
+}
+
+func (h TaskHistory) MarshalJSON() ([]byte, error) {
+	date, err := json.Marshal(h.Date.UnixMilli())
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(taskHistoryJSON{
+		Date:       date,
+		Value:      h.Value,
+		ScoredUp:   h.ScoredUp,
+		ScoredDown: h.ScoredDown,
+		IsDue:      h.IsDue,
+		Completed:  h.Completed,
+	})
 }
 
-type TaskReorderResponse struct {
-	Success bool     `json:"success"`
-	Data    []string `json:"data,omitempty"`
+// TaskGroup links a task to the group it was created in.
+type TaskGroup struct {
+	ID                string        `json:"id,omitempty"`
+	TaskID            string        `json:"taskId,omitempty"`
+	AssignedUsers     []string      `json:"assignedUsers,omitempty"`
+	AssignedDate      time.Time     `json:"assignedDate,omitzero"`
+	AssigningUsername string        `json:"assigningUsername,omitempty"`
+	SharedCompletion  string        `json:"sharedCompletion,omitempty"`
+	ManagerNotes      string        `json:"managerNotes,omitempty"`
+	Approval          *TaskApproval `json:"approval,omitempty"`
 }
 
-type TasksResponse struct {
-	Success bool   `json:"success"`
-	Data    []Task `json:"data,omitempty"`
+type TaskApproval struct {
+	Required      bool      `json:"required"`
+	Approved      bool      `json:"approved"`
+	Requested     bool      `json:"requested"`
+	RequestedDate time.Time `json:"requestedDate,omitzero"`
+	DateApproved  time.Time `json:"dateApproved,omitzero"`
+	ApprovingUser string    `json:"approvingUser,omitempty"`
 }
 
+// TaskChallenge links a task to the challenge it belongs to.
+type TaskChallenge struct {
+	ID        string `json:"id,omitempty"`
+	TaskID    string `json:"taskId,omitempty"`
+	ShortName string `json:"shortName,omitempty"`
+	Broken    string `json:"broken,omitempty"`
+	Winner    string `json:"winner,omitempty"`
+}
+
+// Bool returns a pointer to v, for setting the optional bool fields of Task.
+func Bool(v bool) *bool {
+	return &v
+}
+
+type TaskResponse = Envelope[*Task]
+
+type TaskReorderResponse = Envelope[[]string]
+
+type TasksResponse = Envelope[[]Task]
+
 type ChecklistItem struct {
 	Id        string ` json:"id"`
 	Text      string `json:"text"`
@@ -51,49 +226,47 @@ func newTaskService(h *HabiticaClient) *TaskService {
 	}
 }
 
-func (t *TaskService) getTaskResponse(ctx context.Context, req *http.Request) (*TaskResponse, error) {
-	resp, err := t.client.Do(ctx, req)
-	if err != nil {
-		return nil, fmt.Errorf("unable to perform request: %s", err)
-	}
-	defer resp.Body.Close()
-
-	var taskResp TaskResponse
-	err = json.NewDecoder(resp.Body).Decode(&taskResp)
-	if err != nil {
-		return nil, fmt.Errorf("unable to decode response body: %s", err)
-	}
-	return &taskResp, err
-}
-
 func (t *TaskService) Get(ctx context.Context, id string) (*TaskResponse, error) {
 	req, err := t.client.NewRequest(http.MethodGet, fmt.Sprintf("tasks/%s", id), nil)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create request: %s", err)
 	}
 
-	return t.getTaskResponse(ctx, req)
+	return do[*Task](ctx, t.client, req)
 }
 
-func (t *TaskService) List(ctx context.Context) (*TasksResponse, error) {
-	req, err := t.client.NewRequest(http.MethodGet, "tasks/user", nil)
-	if err != nil {
-		return nil, fmt.Errorf("unable to create request: %s", err)
-	}
+// TaskListType selects which of the user's tasks TaskService.List returns.
+type TaskListType string
+
+const (
+	Habits         TaskListType = "habits"
+	Dailys         TaskListType = "dailys"
+	Todos          TaskListType = "todos"
+	Rewards        TaskListType = "rewards"
+	CompletedTodos TaskListType = "completedTodos"
+)
+
+type TaskListOptions struct {
+	Type TaskListType `url:"type,omitempty"`
+
+	// DueDate computes whether dailies are due on the given date instead of
+	// today. It only applies when listing dailies.
+	DueDate time.Time `url:"dueDate,omitempty"`
+}
 
-	resp, err := t.client.Do(ctx, req)
+// List returns the user's tasks. A nil opts returns all tasks except
+// completed todos.
+func (t *TaskService) List(ctx context.Context, opts *TaskListOptions) (*TasksResponse, error) {
+	urlPath, err := addOptions("tasks/user", opts)
 	if err != nil {
-		return nil, fmt.Errorf("unable to perform request: %s", err)
+		return nil, fmt.Errorf("unable to encode options: %s", err)
 	}
-	defer resp.Body.Close()
-
-	var tasksResp TasksResponse
-	err = json.NewDecoder(resp.Body).Decode(&tasksResp)
+	req, err := t.client.NewRequest(http.MethodGet, urlPath, nil)
 	if err != nil {
-		return nil, fmt.Errorf("unable to decode response body: %s", err)
+		return nil, fmt.Errorf("unable to create request: %s", err)
 	}
 
-	return &tasksResp, err
+	return do[[]Task](ctx, t.client, req)
 }
 
 func (t *TaskService) Update(ctx context.Context, id string, task *Task) (*TaskResponse, error) {
@@ -102,7 +275,7 @@ func (t *TaskService) Update(ctx context.Context, id string, task *Task) (*TaskR
 		return nil, fmt.Errorf("unable to create request: %s", err)
 	}
 
-	return t.getTaskResponse(ctx, req)
+	return do[*Task](ctx, t.client, req)
 }
 
 func (t *TaskService) Create(ctx context.Context, task *Task) (*TaskResponse, error) {
@@ -111,7 +284,7 @@ func (t *TaskService) Create(ctx context.Context, task *Task) (*TaskResponse, er
 		return nil, fmt.Errorf("unable to create request: %s", err)
 	}
 
-	return t.getTaskResponse(ctx, req)
+	return do[*Task](ctx, t.client, req)
 }
 
 func (t *TaskService) Delete(ctx context.Context, id string) (*TaskResponse, error) {
@@ -120,7 +293,7 @@ func (t *TaskService) Delete(ctx context.Context, id string) (*TaskResponse, err
 		return nil, fmt.Errorf("unable to create request: %s", err)
 	}
 
-	return t.getTaskResponse(ctx, req)
+	return do[*Task](ctx, t.client, req)
 }
 
 func (t *TaskService) AddTag(ctx context.Context, taskID, tagID string) (*TaskResponse, error) {
@@ -129,7 +302,7 @@ func (t *TaskService) AddTag(ctx context.Context, taskID, tagID string) (*TaskRe
 		return nil, fmt.Errorf("unable to create request: %s", err)
 	}
 
-	return t.getTaskResponse(ctx, req)
+	return do[*Task](ctx, t.client, req)
 }
 
 func (t *TaskService) DeleteTag(ctx context.Context, taskID, tagID string) (*TaskResponse, error) {
@@ -138,7 +311,7 @@ func (t *TaskService) DeleteTag(ctx context.Context, taskID, tagID string) (*Tas
 		return nil, fmt.Errorf("unable to create request: %s", err)
 	}
 
-	return t.getTaskResponse(ctx, req)
+	return do[*Task](ctx, t.client, req)
 }
 
 func (t *TaskService) AddChecklistItem(ctx context.Context, taskID string, item *ChecklistItem) (*TaskResponse, error) {
@@ -147,7 +320,7 @@ func (t *TaskService) AddChecklistItem(ctx context.Context, taskID string, item
 		return nil, fmt.Errorf("unable to create request: %s", err)
 	}
 
-	return t.getTaskResponse(ctx, req)
+	return do[*Task](ctx, t.client, req)
 }
 
 func (t *TaskService) UpdateChecklistItem(ctx context.Context, taskID string, item *ChecklistItem) (*TaskResponse, error) {
@@ -156,7 +329,7 @@ func (t *TaskService) UpdateChecklistItem(ctx context.Context, taskID string, it
 		return nil, fmt.Errorf("unable to create request: %s", err)
 	}
 
-	return t.getTaskResponse(ctx, req)
+	return do[*Task](ctx, t.client, req)
 }
 
 func (t *TaskService) DeleteChecklistItem(ctx context.Context, taskID, itemID string) (*TaskResponse, error) {
@@ -165,7 +338,7 @@ func (t *TaskService) DeleteChecklistItem(ctx context.Context, taskID, itemID st
 		return nil, fmt.Errorf("unable to create request: %s", err)
 	}
 
-	return t.getTaskResponse(ctx, req)
+	return do[*Task](ctx, t.client, req)
 }
 
 func (t *TaskService) ClearCompletedTodos(ctx context.Context) (*TaskResponse, error) {
@@ -174,7 +347,7 @@ func (t *TaskService) ClearCompletedTodos(ctx context.Context) (*TaskResponse, e
 		return nil, fmt.Errorf("unable to create request: %s", err)
 	}
 
-	return t.getTaskResponse(ctx, req)
+	return do[*Task](ctx, t.client, req)
 }
 
 func (t *TaskService) MoveToPosition(ctx context.Context, taskID string, position int) (*TaskReorderResponse, error) {
@@ -182,16 +355,6 @@ func (t *TaskService) MoveToPosition(ctx context.Context, taskID string, positio
 	if err != nil {
 		return nil, fmt.Errorf("unable to create request: %s", err)
 	}
-	resp, err := t.client.Do(ctx, req)
-	if err != nil {
-		return nil, fmt.Errorf("unable to perform request: %s", err)
-	}
-	defer resp.Body.Close()
-	taskReorderResp := &TaskReorderResponse{}
-	err = json.NewDecoder(resp.Body).Decode(taskReorderResp)
-	if err != nil {
-		return nil, fmt.Errorf("unable to decode response body: %s", err)
-	}
 
-	return taskReorderResp, err
+	return do[[]string](ctx, t.client, req)
 }
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/wfernandes/go-habitica"
 
@@ -98,7 +99,7 @@ func TestGet_UserTasks(t *testing.T) {
 		w.Write(userTasksResponse)
 	})
 
-	resp, err := client.Tasks.List(ctx)
+	resp, err := client.Tasks.List(ctx, nil)
 	Expect(err).ToNot(HaveOccurred())
 	Expect(resp).ToNot(BeNil())
 	Expect(resp.Data).To(HaveLen(1))
@@ -107,6 +108,60 @@ func TestGet_UserTasks(t *testing.T) {
 
 }
 
+func TestList_NoOptions(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/tasks/user", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(userTasksResponse)
+	})
+	_, err := client.Tasks.List(ctx, &habitica.TaskListOptions{})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.URL.RawQuery).To(BeEmpty())
+}
+
+func TestList_FilterByType(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/tasks/user", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(userTasksResponse)
+	})
+	_, err := client.Tasks.List(ctx, &habitica.TaskListOptions{Type: habitica.CompletedTodos})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.URL.Query().Get("type")).To(Equal("completedTodos"))
+	Expect(request.URL.Query()).ToNot(HaveKey("dueDate"))
+}
+
+func TestList_DueDate(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/tasks/user", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(userTasksResponse)
+	})
+	opts := &habitica.TaskListOptions{
+		Type:    habitica.Dailys,
+		DueDate: time.Date(2017, time.January, 14, 8, 0, 0, 0, time.UTC),
+	}
+	_, err := client.Tasks.List(ctx, opts)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.URL.Query().Get("type")).To(Equal("dailys"))
+	Expect(request.URL.Query().Get("dueDate")).To(Equal("2017-01-14T08:00:00Z"))
+}
+
 func TestUpdate_Task(t *testing.T) {
 	RegisterTestingT(t)
 	setup()
@@ -295,6 +350,93 @@ func TestMoveTaskToPosition(t *testing.T) {
 	Expect(resp.Data).To(HaveLen(3))
 }
 
+func TestTask_DecodesHabitFields(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tasks/some-task-id", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write(taskResponse)
+	})
+	resp, err := client.Tasks.Get(ctx, "some-task-id")
+	Expect(err).ToNot(HaveOccurred())
+
+	task := resp.Data
+	Expect(task.Type).To(Equal(habitica.TaskTypeHabit))
+	Expect(task.Alias).To(Equal("apiTrial"))
+	Expect(task.Priority).To(Equal(habitica.PriorityMedium))
+	Expect(task.Attribute).To(Equal(habitica.AttributeStrength))
+	Expect(task.Value).To(BeNumerically("~", 11.99, 0.01))
+	Expect(task.Up).To(Equal(habitica.Bool(true)))
+	Expect(task.Down).To(Equal(habitica.Bool(false)))
+	Expect(task.Challenge.ID).To(Equal("f23c12f2-5830-4f15-9c36-e17fd729a812"))
+	Expect(task.Group.Approval.Required).To(BeFalse())
+	Expect(task.CreatedAt).To(Equal(time.Date(2017, time.January, 12, 19, 3, 33, 495000000, time.UTC)))
+	Expect(task.History).To(HaveLen(1))
+	Expect(task.History[0].Date.Equal(time.UnixMilli(1484248053486))).To(BeTrue())
+	Expect(task.History[0].Value).To(Equal(1.0))
+}
+
+func TestTask_DecodesDailyFields(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tasks/user", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write(userTasksResponse)
+	})
+	resp, err := client.Tasks.List(ctx, nil)
+	Expect(err).ToNot(HaveOccurred())
+
+	task := resp.Data[0]
+	Expect(task.Type).To(Equal(habitica.TaskTypeDaily))
+	Expect(task.Frequency).To(Equal(habitica.FrequencyWeekly))
+	Expect(task.EveryX).To(Equal(1))
+	Expect(task.Streak).To(Equal(1))
+	Expect(task.StartDate).To(Equal(time.Date(2017, time.January, 13, 0, 0, 0, 0, time.UTC)))
+	Expect(task.Repeat).To(Equal(&habitica.Repeat{
+		Monday:    true,
+		Tuesday:   true,
+		Wednesday: true,
+		Thursday:  true,
+		Friday:    true,
+	}))
+	Expect(task.Reminders).To(HaveLen(1))
+	Expect(task.Reminders[0].ID).To(Equal("b8b549c4-8d56-4e49-9b38-b4dcde9763b9"))
+}
+
+func TestTask_RoundTripsThroughUpdate(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	var received map[string]interface{}
+	mux.HandleFunc("/tasks/some-task-id", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			json.NewDecoder(r.Body).Decode(&received)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(taskResponse)
+	})
+	resp, err := client.Tasks.Get(ctx, "some-task-id")
+	Expect(err).ToNot(HaveOccurred())
+	_, err = client.Tasks.Update(ctx, "some-task-id", resp.Data)
+	Expect(err).ToNot(HaveOccurred())
+
+	Expect(received["priority"]).To(Equal(1.5))
+	Expect(received["attribute"]).To(Equal("str"))
+	Expect(received["up"]).To(Equal(true))
+	Expect(received["down"]).To(Equal(false))
+	Expect(received["createdAt"]).To(Equal("2017-01-12T19:03:33.495Z"))
+	Expect(received["history"]).To(Equal([]interface{}{
+		map[string]interface{}{"date": 1484248053486.0, "value": 1.0},
+	}))
+	Expect(received).ToNot(HaveKey("date"))
+	Expect(received).ToNot(HaveKey("frequency"))
+}
+
 var taskReorderResponse = []byte(`
 {
     "success": true,
@@ -0,0 +1,144 @@
+package habitica
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type Class string
+
+const (
+	ClassWarrior Class = "warrior"
+	ClassMage    Class = "wizard"
+	ClassHealer  Class = "healer"
+	ClassRogue   Class = "rogue"
+)
+
+// User is the authenticated user. Only the fields requested with
+// UserService.Get are populated.
+type User struct {
+	ID          string      `json:"id"`
+	Auth        Auth        `json:"auth"`
+	Balance     float64     `json:"balance"`
+	Stats       Stats       `json:"stats"`
+	Preferences Preferences `json:"preferences"`
+	Profile     Profile     `json:"profile"`
+	Items       Items       `json:"items"`
+	Flags       Flags       `json:"flags"`
+
+	// NeedsCron is set when a day boundary has passed since LastCron and
+	// dailies have not been rolled over yet.
+	NeedsCron bool      `json:"needsCron"`
+	LastCron  time.Time `json:"lastCron,omitzero"`
+}
+
+type Auth struct {
+	Local LocalAuth `json:"local"`
+}
+
+type LocalAuth struct {
+	Username string `json:"username"`
+	Email    string `json:"email,omitempty"`
+}
+
+type Stats struct {
+	HP          float64 `json:"hp"`
+	MP          float64 `json:"mp"`
+	Exp         float64 `json:"exp"`
+	GP          float64 `json:"gp"`
+	Lvl         int     `json:"lvl"`
+	Class       Class   `json:"class"`
+	Points      int     `json:"points"`
+	Str         int     `json:"str"`
+	Con         int     `json:"con"`
+	Int         int     `json:"int"`
+	Per         int     `json:"per"`
+	Buffs       Buffs   `json:"buffs"`
+	ToNextLevel int     `json:"toNextLevel"`
+	MaxHealth   int     `json:"maxHealth"`
+	MaxMP       int     `json:"maxMP"`
+}
+
+// Buffs are the temporary stat boosts from skills and special items.
+type Buffs struct {
+	Str            float64 `json:"str"`
+	Int            float64 `json:"int"`
+	Per            float64 `json:"per"`
+	Con            float64 `json:"con"`
+	Stealth        int     `json:"stealth"`
+	Streaks        bool    `json:"streaks"`
+	Snowball       bool    `json:"snowball"`
+	SpookySparkles bool    `json:"spookySparkles"`
+	Shinyseed      bool    `json:"shinySeed"`
+	Seafoam        bool    `json:"seafoam"`
+}
+
+type Preferences struct {
+	DayStart            int    `json:"dayStart"`
+	TimezoneOffset      int    `json:"timezoneOffset"`
+	Language            string `json:"language"`
+	Sleep               bool   `json:"sleep"`
+	DisableClasses      bool   `json:"disableClasses"`
+	AutoEquip           bool   `json:"autoEquip"`
+	Costume             bool   `json:"costume"`
+	DailyDueDefaultView bool   `json:"dailyDueDefaultView"`
+}
+
+type Profile struct {
+	Name     string `json:"name"`
+	Blurb    string `json:"blurb,omitempty"`
+	ImageURL string `json:"imageUrl,omitempty"`
+}
+
+type Flags struct {
+	ClassSelected    bool `json:"classSelected"`
+	ArmoireEnabled   bool `json:"armoireEnabled"`
+	ArmoireEmpty     bool `json:"armoireEmpty"`
+	NewStuff         bool `json:"newStuff"`
+	VerifiedUsername bool `json:"verifiedUsername"`
+}
+
+type UserResponse = Envelope[*User]
+
+type UserService struct {
+	client *HabiticaClient
+}
+
+func newUserService(h *HabiticaClient) *UserService {
+	return &UserService{
+		client: h,
+	}
+}
+
+type userOptions struct {
+	UserFields string `url:"userFields,omitempty"`
+}
+
+// Get returns the authenticated user. If fields are given only those paths
+// (e.g. "stats", "preferences.dayStart") are returned.
+func (s *UserService) Get(ctx context.Context, fields ...string) (*UserResponse, error) {
+	urlPath, err := addOptions("user", &userOptions{UserFields: strings.Join(fields, ",")})
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode options: %s", err)
+	}
+	req, err := s.client.NewRequest(http.MethodGet, urlPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*User](ctx, s.client, req)
+}
+
+// Update sets the given dotted paths on the user, for example
+// {"preferences.dayStart": 4, "profile.name": "Jane"}.
+func (s *UserService) Update(ctx context.Context, updates map[string]any) (*UserResponse, error) {
+	req, err := s.client.NewRequest(http.MethodPut, "user", updates)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*User](ctx, s.client, req)
+}
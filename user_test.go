@@ -0,0 +1,194 @@
+package habitica_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/wfernandes/go-habitica"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestConfigure_UserService(t *testing.T) {
+	RegisterTestingT(t)
+	c, err := habitica.New("user", "api")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(c.User).ToNot(BeNil())
+}
+
+func TestGet_User(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(userResponse)
+	})
+	resp, err := client.User.Get(ctx)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodGet))
+	Expect(request.URL.RawQuery).To(BeEmpty())
+
+	user := resp.Data
+	Expect(user.ID).To(Equal("b0413351-405f-416f-8787-947ec1c85199"))
+	Expect(user.Auth.Local.Username).To(Equal("apitester"))
+	Expect(user.Stats.HP).To(Equal(47.5))
+	Expect(user.Stats.Lvl).To(Equal(12))
+	Expect(user.Stats.Class).To(Equal(habitica.ClassMage))
+	Expect(user.Stats.Buffs.Int).To(Equal(3.0))
+	Expect(user.Stats.Buffs.Streaks).To(BeTrue())
+	Expect(user.Preferences.DayStart).To(Equal(4))
+	Expect(user.Preferences.TimezoneOffset).To(Equal(300))
+	Expect(user.Profile.Name).To(Equal("API Tester"))
+	Expect(user.Items.Eggs).To(HaveKeyWithValue("Wolf", 2))
+	Expect(user.Items.Pets).To(HaveKeyWithValue("Wolf-Base", 5))
+	Expect(user.Items.Mounts).To(HaveKeyWithValue("Wolf-Base", true))
+	Expect(user.Items.Gear.Equipped.Weapon).To(Equal("weapon_wizard_1"))
+	Expect(user.Flags.ClassSelected).To(BeTrue())
+}
+
+func TestGet_UserFields(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(userResponse)
+	})
+	_, err := client.User.Get(ctx, "stats", "preferences.dayStart")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.URL.Query().Get("userFields")).To(Equal("stats,preferences.dayStart"))
+}
+
+func TestUpdate_User(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	var received map[string]interface{}
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&received)
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(userResponse)
+	})
+	resp, err := client.User.Update(ctx, map[string]any{
+		"preferences.dayStart": 4,
+		"profile.name":         "API Tester",
+	})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPut))
+	Expect(received).To(HaveKeyWithValue("preferences.dayStart", 4.0))
+	Expect(received).To(HaveKeyWithValue("profile.name", "API Tester"))
+	Expect(resp.Data.Preferences.DayStart).To(Equal(4))
+}
+
+var userResponse = []byte(`
+{
+    "success": true,
+    "data": {
+        "_id": "b0413351-405f-416f-8787-947ec1c85199",
+        "id": "b0413351-405f-416f-8787-947ec1c85199",
+        "auth": {
+            "local": {
+                "username": "apitester",
+                "email": "apitester@example.com"
+            }
+        },
+        "balance": 2.5,
+        "stats": {
+            "buffs": {
+                "str": 0,
+                "int": 3,
+                "per": 0,
+                "con": 0,
+                "stealth": 0,
+                "streaks": true,
+                "snowball": false,
+                "spookySparkles": false,
+                "shinySeed": false,
+                "seafoam": false
+            },
+            "hp": 47.5,
+            "mp": 30,
+            "exp": 120,
+            "gp": 85.2,
+            "lvl": 12,
+            "class": "wizard",
+            "points": 0,
+            "str": 1,
+            "con": 2,
+            "int": 8,
+            "per": 1,
+            "toNextLevel": 340,
+            "maxHealth": 50,
+            "maxMP": 52
+        },
+        "preferences": {
+            "dayStart": 4,
+            "timezoneOffset": 300,
+            "language": "en",
+            "sleep": false,
+            "disableClasses": false,
+            "autoEquip": true,
+            "costume": false,
+            "dailyDueDefaultView": false
+        },
+        "profile": {
+            "name": "API Tester",
+            "blurb": "Testing the API"
+        },
+        "items": {
+            "gear": {
+                "owned": {
+                    "weapon_wizard_1": true,
+                    "armor_base_0": true
+                },
+                "equipped": {
+                    "weapon": "weapon_wizard_1",
+                    "armor": "armor_base_0"
+                },
+                "costume": {
+                    "armor": "armor_base_0"
+                }
+            },
+            "eggs": {
+                "Wolf": 2
+            },
+            "hatchingPotions": {
+                "Base": 1
+            },
+            "food": {
+                "Meat": 3
+            },
+            "quests": {
+                "dustbunnies": 1
+            },
+            "pets": {
+                "Wolf-Base": 5
+            },
+            "mounts": {
+                "Wolf-Base": true,
+                "Fox-Base": null
+            },
+            "currentPet": "Wolf-Base",
+            "currentMount": "Wolf-Base"
+        },
+        "flags": {
+            "classSelected": true,
+            "armoireEnabled": true,
+            "armoireEmpty": false,
+            "newStuff": false,
+            "verifiedUsername": true
+        }
+    },
+    "notifications": []
+}`)
@@ -0,0 +1,97 @@
+package webhook
+
+import (
+	"github.com/wfernandes/go-habitica"
+)
+
+// Event holds the fields common to every webhook payload.
+type Event struct {
+	WebhookType habitica.WebhookType `json:"webhookType"`
+	Type        string               `json:"type,omitempty"`
+	User        User                 `json:"user"`
+}
+
+// User is the user the webhook belongs to. Stats and Tmp are only sent with
+// task activity.
+type User struct {
+	ID    string             `json:"_id"`
+	Stats *habitica.Stats    `json:"stats,omitempty"`
+	Tmp   *habitica.ScoreTmp `json:"_tmp,omitempty"`
+}
+
+type Group struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type Quest struct {
+	Key string `json:"key"`
+}
+
+type TaskScoredEvent struct {
+	Event
+	Task      habitica.Task           `json:"task"`
+	Direction habitica.ScoreDirection `json:"direction"`
+	Delta     float64                 `json:"delta"`
+}
+
+type TaskCreatedEvent struct {
+	Event
+	Task habitica.Task `json:"task"`
+}
+
+type TaskUpdatedEvent struct {
+	Event
+	Task habitica.Task `json:"task"`
+}
+
+type TaskDeletedEvent struct {
+	Event
+	Task habitica.Task `json:"task"`
+}
+
+type ChecklistScoredEvent struct {
+	Event
+	Task habitica.Task          `json:"task"`
+	Item habitica.ChecklistItem `json:"item"`
+}
+
+type GroupChatReceivedEvent struct {
+	Event
+	Group Group                `json:"group"`
+	Chat  habitica.ChatMessage `json:"chat"`
+}
+
+type PetHatchedEvent struct {
+	Event
+	Pet string `json:"pet"`
+}
+
+type MountRaisedEvent struct {
+	Event
+	Mount string `json:"mount"`
+}
+
+type LeveledUpEvent struct {
+	Event
+	InitialLvl int `json:"initialLvl"`
+	FinalLvl   int `json:"finalLvl"`
+}
+
+type QuestStartedEvent struct {
+	Event
+	Group Group `json:"group"`
+	Quest Quest `json:"quest"`
+}
+
+type QuestFinishedEvent struct {
+	Event
+	Group Group `json:"group"`
+	Quest Quest `json:"quest"`
+}
+
+type QuestInvitedEvent struct {
+	Event
+	Group Group `json:"group"`
+	Quest Quest `json:"quest"`
+}
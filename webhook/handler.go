@@ -0,0 +1,148 @@
+// Package webhook receives the webhooks Habitica sends for the types
+// managed with habitica.WebhookService.
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/wfernandes/go-habitica"
+)
+
+// MaxBodySize is the largest payload the Handler accepts.
+const MaxBodySize = 1 << 20
+
+var errMalformed = errors.New("malformed webhook payload")
+
+// Handler is an http.Handler that decodes webhook payloads and dispatches
+// them to the registered callbacks.
+//
+// Payloads for another user are rejected with 403 and malformed payloads
+// with 400. Events without a callback are acknowledged and dropped. If a
+// callback returns an error the Handler responds with 500, which Habitica
+// counts as a failed delivery.
+type Handler struct {
+	userID   string
+	handlers map[string]func(context.Context, []byte) error
+}
+
+// NewHandler returns a Handler accepting webhooks for the given user id.
+func NewHandler(userID string) *Handler {
+	return &Handler{
+		userID:   userID,
+		handlers: map[string]func(context.Context, []byte) error{},
+	}
+}
+
+func (h *Handler) OnTaskScored(fn func(context.Context, *TaskScoredEvent) error) {
+	on(h, habitica.WebhookTaskActivity, "scored", fn)
+}
+
+func (h *Handler) OnTaskCreated(fn func(context.Context, *TaskCreatedEvent) error) {
+	on(h, habitica.WebhookTaskActivity, "created", fn)
+}
+
+func (h *Handler) OnTaskUpdated(fn func(context.Context, *TaskUpdatedEvent) error) {
+	on(h, habitica.WebhookTaskActivity, "updated", fn)
+}
+
+func (h *Handler) OnTaskDeleted(fn func(context.Context, *TaskDeletedEvent) error) {
+	on(h, habitica.WebhookTaskActivity, "deleted", fn)
+}
+
+func (h *Handler) OnChecklistScored(fn func(context.Context, *ChecklistScoredEvent) error) {
+	on(h, habitica.WebhookTaskActivity, "checklistScored", fn)
+}
+
+func (h *Handler) OnGroupChatReceived(fn func(context.Context, *GroupChatReceivedEvent) error) {
+	on(h, habitica.WebhookGroupChatReceived, "", fn)
+}
+
+func (h *Handler) OnPetHatched(fn func(context.Context, *PetHatchedEvent) error) {
+	on(h, habitica.WebhookUserActivity, "petHatched", fn)
+}
+
+func (h *Handler) OnMountRaised(fn func(context.Context, *MountRaisedEvent) error) {
+	on(h, habitica.WebhookUserActivity, "mountRaised", fn)
+}
+
+func (h *Handler) OnLeveledUp(fn func(context.Context, *LeveledUpEvent) error) {
+	on(h, habitica.WebhookUserActivity, "leveledUp", fn)
+}
+
+func (h *Handler) OnQuestStarted(fn func(context.Context, *QuestStartedEvent) error) {
+	on(h, habitica.WebhookQuestActivity, "questStarted", fn)
+}
+
+func (h *Handler) OnQuestFinished(fn func(context.Context, *QuestFinishedEvent) error) {
+	on(h, habitica.WebhookQuestActivity, "questFinished", fn)
+}
+
+func (h *Handler) OnQuestInvited(fn func(context.Context, *QuestInvitedEvent) error) {
+	on(h, habitica.WebhookQuestActivity, "questInvited", fn)
+}
+
+// on registers fn for the event, replacing any previous callback.
+func on[E any](h *Handler, webhookType habitica.WebhookType, eventType string, fn func(context.Context, *E) error) {
+	h.handlers[eventKey(webhookType, eventType)] = func(ctx context.Context, data []byte) error {
+		e := new(E)
+		err := json.Unmarshal(data, e)
+		if err != nil {
+			return errMalformed
+		}
+		return fn(ctx, e)
+	}
+}
+
+func eventKey(webhookType habitica.WebhookType, eventType string) string {
+	// Group chat payloads have no type, so every one of them is the same
+	// event.
+	if webhookType == habitica.WebhookGroupChatReceived {
+		eventType = ""
+	}
+	return string(webhookType) + "." + eventType
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxBodySize))
+	if err != nil {
+		http.Error(w, "unable to read body", http.StatusBadRequest)
+		return
+	}
+
+	var event Event
+	err = json.Unmarshal(data, &event)
+	if err != nil || event.WebhookType == "" {
+		http.Error(w, errMalformed.Error(), http.StatusBadRequest)
+		return
+	}
+	if event.User.ID != h.userID {
+		http.Error(w, "webhook is for another user", http.StatusForbidden)
+		return
+	}
+
+	handler, ok := h.handlers[eventKey(event.WebhookType, event.Type)]
+	if !ok {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	err = handler(r.Context(), data)
+	if errors.Is(err, errMalformed) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
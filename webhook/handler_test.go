@@ -0,0 +1,215 @@
+package webhook_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/wfernandes/go-habitica"
+	"github.com/wfernandes/go-habitica/webhook"
+
+	. "github.com/onsi/gomega"
+)
+
+const userID = "b0413351-405f-416f-8787-947ec1c85199"
+
+func post(h http.Handler, body []byte) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body))
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandler_TaskScored(t *testing.T) {
+	RegisterTestingT(t)
+	h := webhook.NewHandler(userID)
+
+	var event *webhook.TaskScoredEvent
+	h.OnTaskScored(func(ctx context.Context, e *webhook.TaskScoredEvent) error {
+		event = e
+		return nil
+	})
+	rec := post(h, taskScoredPayload)
+	Expect(rec.Code).To(Equal(http.StatusNoContent))
+
+	Expect(event).ToNot(BeNil())
+	Expect(event.WebhookType).To(Equal(habitica.WebhookTaskActivity))
+	Expect(event.Type).To(Equal("scored"))
+	Expect(event.Direction).To(Equal(habitica.Up))
+	Expect(event.Delta).To(Equal(1.5))
+	Expect(event.Task.Text).To(Equal("Write report"))
+	Expect(event.Task.Type).To(Equal(habitica.TaskTypeTodo))
+	Expect(event.User.ID).To(Equal(userID))
+	Expect(event.User.Stats.GP).To(Equal(86.4))
+	Expect(event.User.Tmp.Drop.Key).To(Equal("Wolf"))
+}
+
+func TestHandler_TaskCreated(t *testing.T) {
+	RegisterTestingT(t)
+	h := webhook.NewHandler(userID)
+
+	var event *webhook.TaskCreatedEvent
+	h.OnTaskCreated(func(ctx context.Context, e *webhook.TaskCreatedEvent) error {
+		event = e
+		return nil
+	})
+	h.OnTaskScored(func(ctx context.Context, e *webhook.TaskScoredEvent) error {
+		return errors.New("should not be called")
+	})
+	rec := post(h, []byte(`{"webhookType": "taskActivity", "type": "created", "user": {"_id": "`+userID+`"}, "task": {"id": "some-task-id", "text": "New habit", "type": "habit"}}`))
+	Expect(rec.Code).To(Equal(http.StatusNoContent))
+	Expect(event.Task.ID).To(Equal("some-task-id"))
+	Expect(event.Task.Text).To(Equal("New habit"))
+}
+
+func TestHandler_GroupChatReceived(t *testing.T) {
+	RegisterTestingT(t)
+	h := webhook.NewHandler(userID)
+
+	var event *webhook.GroupChatReceivedEvent
+	h.OnGroupChatReceived(func(ctx context.Context, e *webhook.GroupChatReceivedEvent) error {
+		event = e
+		return nil
+	})
+	rec := post(h, []byte(`{"webhookType": "groupChatReceived", "user": {"_id": "`+userID+`"}, "group": {"id": "some-group-id", "name": "The Party"}, "chat": {"id": "msg-id", "text": "hello", "timestamp": 1577934245000, "uuid": "other-user"}}`))
+	Expect(rec.Code).To(Equal(http.StatusNoContent))
+	Expect(event.Group.Name).To(Equal("The Party"))
+	Expect(event.Chat.Text).To(Equal("hello"))
+	Expect(event.Chat.Timestamp.UnixMilli()).To(Equal(int64(1577934245000)))
+}
+
+func TestHandler_UserAndQuestActivity(t *testing.T) {
+	RegisterTestingT(t)
+	h := webhook.NewHandler(userID)
+
+	var leveledUp *webhook.LeveledUpEvent
+	h.OnLeveledUp(func(ctx context.Context, e *webhook.LeveledUpEvent) error {
+		leveledUp = e
+		return nil
+	})
+	var questFinished *webhook.QuestFinishedEvent
+	h.OnQuestFinished(func(ctx context.Context, e *webhook.QuestFinishedEvent) error {
+		questFinished = e
+		return nil
+	})
+
+	rec := post(h, []byte(`{"webhookType": "userActivity", "type": "leveledUp", "user": {"_id": "`+userID+`"}, "initialLvl": 11, "finalLvl": 12}`))
+	Expect(rec.Code).To(Equal(http.StatusNoContent))
+	Expect(leveledUp.InitialLvl).To(Equal(11))
+	Expect(leveledUp.FinalLvl).To(Equal(12))
+
+	rec = post(h, []byte(`{"webhookType": "questActivity", "type": "questFinished", "user": {"_id": "`+userID+`"}, "group": {"id": "party-id", "name": "The Party"}, "quest": {"key": "dustbunnies"}}`))
+	Expect(rec.Code).To(Equal(http.StatusNoContent))
+	Expect(questFinished.Quest.Key).To(Equal("dustbunnies"))
+	Expect(questFinished.Group.ID).To(Equal("party-id"))
+}
+
+func TestHandler_UnhandledEvent(t *testing.T) {
+	RegisterTestingT(t)
+	h := webhook.NewHandler(userID)
+
+	rec := post(h, []byte(`{"webhookType": "userActivity", "type": "petHatched", "user": {"_id": "`+userID+`"}, "pet": "Wolf-Base"}`))
+	Expect(rec.Code).To(Equal(http.StatusNoContent))
+}
+
+func TestHandler_RejectsOtherUser(t *testing.T) {
+	RegisterTestingT(t)
+	h := webhook.NewHandler(userID)
+
+	called := false
+	h.OnTaskScored(func(ctx context.Context, e *webhook.TaskScoredEvent) error {
+		called = true
+		return nil
+	})
+	rec := post(h, []byte(`{"webhookType": "taskActivity", "type": "scored", "user": {"_id": "someone-else"}}`))
+	Expect(rec.Code).To(Equal(http.StatusForbidden))
+	Expect(called).To(BeFalse())
+}
+
+func TestHandler_RejectsMalformedBodies(t *testing.T) {
+	RegisterTestingT(t)
+	h := webhook.NewHandler(userID)
+	h.OnTaskScored(func(ctx context.Context, e *webhook.TaskScoredEvent) error {
+		return nil
+	})
+
+	Expect(post(h, []byte(`not json`)).Code).To(Equal(http.StatusBadRequest))
+	Expect(post(h, []byte(`{"user": {"_id": "`+userID+`"}}`)).Code).To(Equal(http.StatusBadRequest))
+	Expect(post(h, []byte(`{"webhookType": "taskActivity", "type": "scored", "user": {"_id": "`+userID+`"}, "delta": "lots"}`)).Code).To(Equal(http.StatusBadRequest))
+	Expect(post(h, bytes.Repeat([]byte(" "), webhook.MaxBodySize+1)).Code).To(Equal(http.StatusBadRequest))
+}
+
+func TestHandler_RejectsOtherMethods(t *testing.T) {
+	RegisterTestingT(t)
+	h := webhook.NewHandler(userID)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/webhook", nil))
+	Expect(rec.Code).To(Equal(http.StatusMethodNotAllowed))
+	Expect(rec.Header().Get("Allow")).To(Equal(http.MethodPost))
+}
+
+func TestHandler_CallbackError(t *testing.T) {
+	RegisterTestingT(t)
+	h := webhook.NewHandler(userID)
+	h.OnTaskScored(func(ctx context.Context, e *webhook.TaskScoredEvent) error {
+		return errors.New("database is down")
+	})
+
+	rec := post(h, taskScoredPayload)
+	Expect(rec.Code).To(Equal(http.StatusInternalServerError))
+	Expect(rec.Body.String()).ToNot(ContainSubstring("database"))
+}
+
+func TestHandler_WithServer(t *testing.T) {
+	RegisterTestingT(t)
+	h := webhook.NewHandler(userID)
+
+	scored := make(chan *webhook.TaskScoredEvent, 1)
+	h.OnTaskScored(func(ctx context.Context, e *webhook.TaskScoredEvent) error {
+		scored <- e
+		return nil
+	})
+	ts := httptest.NewServer(h)
+	defer ts.Close()
+
+	resp, err := http.Post(ts.URL, "application/json", bytes.NewReader(taskScoredPayload))
+	Expect(err).ToNot(HaveOccurred())
+	resp.Body.Close()
+	Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+	Expect((<-scored).Task.Text).To(Equal("Write report"))
+}
+
+var taskScoredPayload = []byte(`
+{
+    "webhookType": "taskActivity",
+    "type": "scored",
+    "direction": "up",
+    "delta": 1.5,
+    "task": {
+        "id": "some-task-id",
+        "text": "Write report",
+        "type": "todo",
+        "completed": true,
+        "value": 2.5
+    },
+    "user": {
+        "_id": "b0413351-405f-416f-8787-947ec1c85199",
+        "_tmp": {
+            "drop": {
+                "type": "Egg",
+                "key": "Wolf"
+            }
+        },
+        "stats": {
+            "hp": 50,
+            "mp": 32,
+            "exp": 140,
+            "gp": 86.4,
+            "lvl": 12
+        }
+    }
+}`)
@@ -0,0 +1,185 @@
+package habitica
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// WebhookType is the kind of activity a webhook is sent for.
+type WebhookType string
+
+const (
+	WebhookTaskActivity      WebhookType = "taskActivity"
+	WebhookGroupChatReceived WebhookType = "groupChatReceived"
+	WebhookUserActivity      WebhookType = "userActivity"
+	WebhookQuestActivity     WebhookType = "questActivity"
+)
+
+// WebhookOptions selects the events a webhook is sent for. It is one of
+// *TaskActivityOptions, *GroupChatReceivedOptions, *UserActivityOptions or
+// *QuestActivityOptions.
+type WebhookOptions interface {
+	WebhookType() WebhookType
+}
+
+type TaskActivityOptions struct {
+	Created         bool `json:"created"`
+	Updated         bool `json:"updated"`
+	Deleted         bool `json:"deleted"`
+	Scored          bool `json:"scored"`
+	ChecklistScored bool `json:"checklistScored"`
+}
+
+func (*TaskActivityOptions) WebhookType() WebhookType { return WebhookTaskActivity }
+
+type GroupChatReceivedOptions struct {
+	GroupID string `json:"groupId"`
+}
+
+func (*GroupChatReceivedOptions) WebhookType() WebhookType { return WebhookGroupChatReceived }
+
+type UserActivityOptions struct {
+	PetHatched  bool `json:"petHatched"`
+	MountRaised bool `json:"mountRaised"`
+	LeveledUp   bool `json:"leveledUp"`
+}
+
+func (*UserActivityOptions) WebhookType() WebhookType { return WebhookUserActivity }
+
+type QuestActivityOptions struct {
+	QuestStarted  bool `json:"questStarted"`
+	QuestFinished bool `json:"questFinished"`
+}
+
+func (*QuestActivityOptions) WebhookType() WebhookType { return WebhookQuestActivity }
+
+type Webhook struct {
+	ID            string         `json:"id,omitempty"`
+	URL           string         `json:"url,omitempty"`
+	Label         string         `json:"label,omitempty"`
+	Type          WebhookType    `json:"type,omitempty"`
+	Enabled       *bool          `json:"enabled,omitempty"`
+	Failures      int            `json:"failures,omitempty"`
+	LastFailureAt time.Time      `json:"lastFailureAt,omitzero"`
+	Options       WebhookOptions `json:"options,omitempty"`
+}
+
+// UnmarshalJSON decodes the options into the type matching the webhook type.
+// Options of an unknown type are left nil.
+func (w *Webhook) UnmarshalJSON(data []byte) error {
+	type webhook Webhook
+	var raw struct {
+		webhook
+		Options json.RawMessage `json:"options"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	*w = Webhook(raw.webhook)
+	w.Options = newWebhookOptions(w.Type)
+	if w.Options == nil || len(raw.Options) == 0 || string(raw.Options) == "null" {
+		return nil
+	}
+	return json.Unmarshal(raw.Options, w.Options)
+}
+
+// MarshalJSON fills in the type from the options when it is not set.
+func (w Webhook) MarshalJSON() ([]byte, error) {
+	type webhook Webhook
+	if w.Type == "" && w.Options != nil {
+		w.Type = w.Options.WebhookType()
+	}
+	return json.Marshal(webhook(w))
+}
+
+func newWebhookOptions(t WebhookType) WebhookOptions {
+	switch t {
+	case WebhookTaskActivity:
+		return &TaskActivityOptions{}
+	case WebhookGroupChatReceived:
+		return &GroupChatReceivedOptions{}
+	case WebhookUserActivity:
+		return &UserActivityOptions{}
+	case WebhookQuestActivity:
+		return &QuestActivityOptions{}
+	}
+	return nil
+}
+
+type WebhookResponse = Envelope[*Webhook]
+
+type WebhooksResponse = Envelope[[]Webhook]
+
+type WebhookService struct {
+	client *HabiticaClient
+}
+
+func newWebhookService(h *HabiticaClient) *WebhookService {
+	return &WebhookService{
+		client: h,
+	}
+}
+
+func (s *WebhookService) List(ctx context.Context) (*WebhooksResponse, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "user/webhook", nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[[]Webhook](ctx, s.client, req)
+}
+
+// Create registers a webhook. The type may be left empty when options are
+// given.
+func (s *WebhookService) Create(ctx context.Context, webhook *Webhook) (*WebhookResponse, error) {
+	err := validateWebhook(webhook)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(http.MethodPost, "user/webhook", webhook)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*Webhook](ctx, s.client, req)
+}
+
+// Update replaces the url, label, type, enabled state and options of the
+// webhook with the given id. Empty fields are left unchanged.
+func (s *WebhookService) Update(ctx context.Context, id string, webhook *Webhook) (*WebhookResponse, error) {
+	err := validateWebhook(webhook)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(http.MethodPut, fmt.Sprintf("user/webhook/%s", id), webhook)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[*Webhook](ctx, s.client, req)
+}
+
+// Delete removes a webhook and returns the remaining ones.
+func (s *WebhookService) Delete(ctx context.Context, id string) (*WebhooksResponse, error) {
+	req, err := s.client.NewRequest(http.MethodDelete, fmt.Sprintf("user/webhook/%s", id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %s", err)
+	}
+
+	return do[[]Webhook](ctx, s.client, req)
+}
+
+func validateWebhook(webhook *Webhook) error {
+	if webhook == nil {
+		return fmt.Errorf("webhook is required")
+	}
+	if webhook.Options != nil && webhook.Type != "" && webhook.Options.WebhookType() != webhook.Type {
+		return fmt.Errorf("webhook options for %s do not match type %s", webhook.Options.WebhookType(), webhook.Type)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package habitica_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/wfernandes/go-habitica"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestList_Webhooks(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/user/webhook", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write(webhooksResponse)
+	})
+	resp, err := client.Webhooks.List(ctx)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodGet))
+	Expect(resp.Data).To(HaveLen(3))
+
+	tasks := resp.Data[0]
+	Expect(tasks.ID).To(Equal("43a2c6ff-ba0c-4ab4-8e3e-1d2dc0e0b18c"))
+	Expect(tasks.Type).To(Equal(habitica.WebhookTaskActivity))
+	Expect(*tasks.Enabled).To(BeTrue())
+	Expect(tasks.Options).To(Equal(&habitica.TaskActivityOptions{Created: true, Scored: true}))
+
+	chat := resp.Data[1]
+	Expect(chat.Failures).To(Equal(2))
+	Expect(chat.LastFailureAt.IsZero()).To(BeFalse())
+	Expect(chat.Options).To(Equal(&habitica.GroupChatReceivedOptions{GroupID: "some-group-id"}))
+
+	Expect(resp.Data[2].Options).To(Equal(&habitica.UserActivityOptions{LeveledUp: true}))
+}
+
+func TestCreate_Webhook(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	var received map[string]interface{}
+	mux.HandleFunc("/user/webhook", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&received)
+		request = r
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"success": true, "data": {"id": "new-id", "url": "https://example.com/hook", "type": "questActivity", "enabled": true, "options": {"questStarted": true, "questFinished": false}}}`))
+	})
+	resp, err := client.Webhooks.Create(ctx, &habitica.Webhook{
+		URL:     "https://example.com/hook",
+		Label:   "quests",
+		Options: &habitica.QuestActivityOptions{QuestStarted: true},
+	})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPost))
+	Expect(received).To(HaveKeyWithValue("type", "questActivity"))
+	Expect(received).To(HaveKeyWithValue("url", "https://example.com/hook"))
+	Expect(received).To(HaveKeyWithValue("options", map[string]interface{}{"questStarted": true, "questFinished": false}))
+	Expect(received).ToNot(HaveKey("enabled"))
+	Expect(received).ToNot(HaveKey("id"))
+	Expect(resp.Data.ID).To(Equal("new-id"))
+	Expect(resp.Data.Options).To(Equal(&habitica.QuestActivityOptions{QuestStarted: true}))
+}
+
+func TestCreate_WebhookMismatchedOptions(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	called := false
+	mux.HandleFunc("/user/webhook", func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+	_, err := client.Webhooks.Create(ctx, &habitica.Webhook{
+		URL:     "https://example.com/hook",
+		Type:    habitica.WebhookTaskActivity,
+		Options: &habitica.UserActivityOptions{PetHatched: true},
+	})
+	Expect(err).To(HaveOccurred())
+	Expect(called).To(BeFalse())
+}
+
+func TestUpdate_Webhook(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	var received map[string]interface{}
+	mux.HandleFunc("/user/webhook/some-id", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&received)
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": {"id": "some-id", "url": "https://example.com/hook", "type": "taskActivity", "enabled": false, "options": {"scored": true}}}`))
+	})
+	resp, err := client.Webhooks.Update(ctx, "some-id", &habitica.Webhook{
+		URL:     "https://example.com/hook",
+		Enabled: habitica.Bool(false),
+	})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodPut))
+	Expect(received).To(HaveKeyWithValue("enabled", false))
+	Expect(received).ToNot(HaveKey("options"))
+	Expect(received).ToNot(HaveKey("type"))
+	Expect(*resp.Data.Enabled).To(BeFalse())
+}
+
+func TestDelete_Webhook(t *testing.T) {
+	RegisterTestingT(t)
+	setup()
+	defer teardown()
+
+	request := &http.Request{}
+	mux.HandleFunc("/user/webhook/some-id", func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"success": true, "data": []}`))
+	})
+	resp, err := client.Webhooks.Delete(ctx, "some-id")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(request.Method).To(Equal(http.MethodDelete))
+	Expect(resp.Data).To(BeEmpty())
+}
+
+var webhooksResponse = []byte(`
+{
+    "success": true,
+    "data": [
+        {
+            "id": "43a2c6ff-ba0c-4ab4-8e3e-1d2dc0e0b18c",
+            "url": "https://example.com/tasks",
+            "label": "tasks",
+            "type": "taskActivity",
+            "enabled": true,
+            "failures": 0,
+            "options": {
+                "created": true,
+                "updated": false,
+                "deleted": false,
+                "scored": true,
+                "checklistScored": false
+            }
+        },
+        {
+            "id": "0b9a3a62-0e2c-4a8b-9a1e-2d2f0c6a7c11",
+            "url": "https://example.com/chat",
+            "type": "groupChatReceived",
+            "enabled": true,
+            "failures": 2,
+            "lastFailureAt": "2020-01-02T03:04:05.000Z",
+            "options": {
+                "groupId": "some-group-id"
+            }
+        },
+        {
+            "id": "6f2f1a5e-7d0f-4c7b-9b1a-5e4c3d2b1a00",
+            "url": "https://example.com/user",
+            "type": "userActivity",
+            "enabled": true,
+            "options": {
+                "petHatched": false,
+                "mountRaised": false,
+                "leveledUp": true
+            }
+        }
+    ],
+    "notifications": []
+}`)